@@ -1,6 +1,7 @@
 package steam
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -10,35 +11,49 @@ import (
 // PlayerSummariesResponse represents the response from the GetPlayerSummaries API call
 type PlayerSummariesResponse struct {
 	Response struct {
-		Players []struct {
-			SteamID      string `json:"steamid"`
-			PersonaName  string `json:"personaname"`
-			ProfileURL   string `json:"profileurl"`
-			Avatar       string `json:"avatar"`
-			AvatarMedium string `json:"avatarmedium"`
-			AvatarFull   string `json:"avatarfull"`
-		} `json:"players"`
+		Players []PlayerSummary `json:"players"`
 	} `json:"response"`
 }
 
+// PlayerSummary represents a single player returned by the GetPlayerSummaries API call.
+// Fields after ProfileState are only present if the profile is visible to the caller.
+type PlayerSummary struct {
+	SteamID                  string `json:"steamid"`
+	PersonaName              string `json:"personaname"`
+	ProfileURL               string `json:"profileurl"`
+	Avatar                   string `json:"avatar"`
+	AvatarMedium             string `json:"avatarmedium"`
+	AvatarFull               string `json:"avatarfull"`
+	AvatarHash               string `json:"avatarhash"`
+	PersonaState             int    `json:"personastate"`
+	PersonaStateFlags        int    `json:"personastateflags"`
+	CommunityVisibilityState int    `json:"communityvisibilitystate"`
+	ProfileState             int    `json:"profilestate"`
+	LastLogoff               int64  `json:"lastlogoff"`
+	CommentPermission        int    `json:"commentpermission"`
+
+	RealName       string `json:"realname"`
+	PrimaryClanID  string `json:"primaryclanid"`
+	TimeCreated    int64  `json:"timecreated"`
+	GameID         string `json:"gameid"`
+	GameServerIP   string `json:"gameserverip"`
+	GameExtraInfo  string `json:"gameextrainfo"`
+	LocCountryCode string `json:"loccountrycode"`
+	LocStateCode   string `json:"locstatecode"`
+	LocCityID      int    `json:"loccityid"`
+}
+
 // PlayerInventoryResponse represents the response from the GetPlayerInventories API call
 type PlayerInventoryResponse struct {
-	Assets []struct {
-		AppID      int    `json:"appid"`
-		ContextID  string `json:"contextid"`
-		AssetID    string `json:"assetid"`
-		ClassID    string `json:"classid"`
-		InstanceID string `json:"instanceid"`
-		Amount     string `json:"amount"`
-	} `json:"assets"`
-	Descriptions []struct {
-		AppID          int    `json:"appid"`
-		ClassID        string `json:"classid"`
-		InstanceID     string `json:"instanceid"`
-		Name           string `json:"name"`
-		MarketHashName string `json:"market_hash_name"`
-		IconURL        string `json:"icon_url"`
-	} `json:"descriptions"`
+	Assets              []InventoryAsset       `json:"assets"`
+	Descriptions        []InventoryDescription `json:"descriptions"`
+	MoreItems           int                    `json:"more_items"`
+	LastAssetID         string                 `json:"last_assetid"`
+	TotalInventoryCount int                    `json:"total_inventory_count"`
+	Success             int                    `json:"success"`
+
+	// Items joins every asset with its description; filled in by InventoryIterator and CollectInventory
+	Items []InventoryItem `json:"-"`
 }
 
 // UserStatsForGameResponse represents the response from the GetUserStatsForGame API call
@@ -86,6 +101,19 @@ type RecentlyPlayedGamesResponse struct {
 	} `json:"response"`
 }
 
+// PlayerBansResponse represents the response from the GetPlayerBans API call
+type PlayerBansResponse struct {
+	Players []struct {
+		SteamID          string `json:"SteamId"`
+		CommunityBanned  bool   `json:"CommunityBanned"`
+		VACBanned        bool   `json:"VACBanned"`
+		NumberOfVACBans  int    `json:"NumberOfVACBans"`
+		DaysSinceLastBan int    `json:"DaysSinceLastBan"`
+		NumberOfGameBans int    `json:"NumberOfGameBans"`
+		EconomyBan       string `json:"EconomyBan"`
+	} `json:"players"`
+}
+
 // GetPlayerSummaries fetches player summaries from Steam API
 // apiKey: Steam Web API key
 // steamID: SteamID64 of the player
@@ -120,16 +148,45 @@ func GetPlayerSummaries(apiKey, steamID string) (*PlayerSummariesResponse, error
 	return &result, nil
 }
 
-// GetPlayerInventories fetches player inventories from Steam API
+// GetPlayerInventories fetches a player's complete inventory from Steam API, following
+// pagination until every page has been loaded. If an inventory cache is set, cached
+// inventories are returned without a request. Private inventories fail with a *PrivateInventoryError.
 // apiKey: Steam Web API key
 // steamID: SteamID64 of the player
 // appID: Application ID (e.g., 730 for CS:GO)
 // contextID: Context ID (e.g., 2 for CS:GO)
 func GetPlayerInventories(apiKey, steamID string, appID, contextID int) (*PlayerInventoryResponse, error) {
+	cache := inventoryCache.Load()
+	if cache != nil {
+		if inventory, ok := cache.Get(steamID, appID, contextID); ok {
+			return inventory, nil
+		}
+	}
+
+	inventory, err := CollectInventory(NewInventoryIterator(steamID, appID, contextID))
+	if err != nil {
+		return nil, err
+	}
+
+	if cache != nil {
+		cache.Set(steamID, appID, contextID, inventory)
+	}
+	return inventory, nil
+}
+
+// getPlayerInventoryPage fetches a single page of a player's inventory from Steam API
+// steamID: SteamID64 of the player
+// appID: Application ID (e.g., 730 for CS:GO)
+// contextID: Context ID (e.g., 2 for CS:GO)
+// startAssetID: Asset ID to start the page after, or "" for the first page
+func getPlayerInventoryPage(steamID string, appID, contextID int, startAssetID string) (*PlayerInventoryResponse, error) {
 	rateLimiter.Wait()
 
 	// Build the URL for the API request
-	url := fmt.Sprintf("https://steamcommunity.com/inventory/%s/%d/%d?l=english&count=5000", steamID, appID, contextID)
+	url := fmt.Sprintf("https://steamcommunity.com/inventory/%s/%d/%d?l=english&count=%d", steamID, appID, contextID, inventoryPageSize)
+	if startAssetID != "" {
+		url += "&start_assetid=" + startAssetID
+	}
 	resp, err := http.Get(url)
 	if err != nil {
 		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
@@ -141,6 +198,18 @@ func GetPlayerInventories(apiKey, steamID string, appID, contextID int) (*Player
 		}
 	}(resp.Body)
 
+	// Steam answers 403 with a null body for private and friends-only inventories
+	if resp.StatusCode == http.StatusForbidden {
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read response body: %w", err)
+		}
+		if trimmed := bytes.TrimSpace(body); len(trimmed) == 0 || bytes.Equal(trimmed, []byte("null")) {
+			return nil, &PrivateInventoryError{SteamID: steamID, AppID: appID, ContextID: contextID, Visibility: VisibilityPrivate}
+		}
+		return nil, fmt.Errorf("steam API returned non-OK status: %s", resp.Status)
+	}
+
 	// Check for non-OK HTTP status code
 	if resp.StatusCode != http.StatusOK {
 		return nil, fmt.Errorf("steam API returned non-OK status: %s", resp.Status)
@@ -251,3 +320,37 @@ func GetRecentlyPlayedGames(apiKey, steamID string) (*RecentlyPlayedGamesRespons
 
 	return &result, nil
 }
+
+// GetPlayerBans fetches the community, VAC and trade ban status of a player from the Steam API
+// apiKey: Steam Web API key
+// steamID: SteamID64 of the player
+func GetPlayerBans(apiKey, steamID string) (*PlayerBansResponse, error) {
+	rateLimiter.Wait()
+
+	// Build the URL for the API request
+	url := fmt.Sprintf("https://api.steampowered.com/ISteamUser/GetPlayerBans/v1/?key=%s&steamids=%s", apiKey, steamID)
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+
+		}
+	}(resp.Body)
+
+	// Check for non-OK HTTP status code
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("steam API returned non-OK status: %s", resp.Status)
+	}
+
+	// Decode the JSON response
+	var result PlayerBansResponse
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode JSON response: %w", err)
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,126 @@
+package steam
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"sync"
+	"time"
+)
+
+// AuditAction identifies the kind of mutating call recorded in the audit journal
+type AuditAction string
+
+const (
+	AuditActionSendTradeOffer      AuditAction = "send_trade_offer"
+	AuditActionAcceptTradeOffer    AuditAction = "accept_trade_offer"
+	AuditActionDeclineTradeOffer   AuditAction = "decline_trade_offer"
+	AuditActionListMarketItem      AuditAction = "list_market_item"
+	AuditActionRemoveListing       AuditAction = "remove_listing"
+	AuditActionCreateBuyOrder      AuditAction = "create_buy_order"
+	AuditActionCancelBuyOrder      AuditAction = "cancel_buy_order"
+	AuditActionAddFriend           AuditAction = "add_friend"
+	AuditActionRemoveFriend        AuditAction = "remove_friend"
+	AuditActionAcceptFriendRequest AuditAction = "accept_friend_request"
+	AuditActionConfirmation        AuditAction = "confirmation"
+)
+
+// AuditOutcome records whether an audited call succeeded
+type AuditOutcome string
+
+const (
+	AuditOutcomeSuccess AuditOutcome = "success"
+	AuditOutcomeFailure AuditOutcome = "failure"
+)
+
+// AuditEntry represents a single record in the audit journal
+type AuditEntry struct {
+	Time    time.Time              `json:"time"`
+	Action  AuditAction            `json:"action"`
+	BotID   string                 `json:"bot_steamid,omitempty"`
+	Request map[string]interface{} `json:"request,omitempty"`
+	Outcome AuditOutcome           `json:"outcome"`
+	Error   string                 `json:"error,omitempty"`
+	IDs     map[string]string      `json:"ids,omitempty"`
+}
+
+// AuditSink receives audit entries; implementations must be safe for concurrent use
+type AuditSink interface {
+	Record(entry AuditEntry) error
+}
+
+// JSONLinesAuditSink is an append-only audit sink writing one JSON object per line to a file
+type JSONLinesAuditSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewJSONLinesAuditSink opens (or creates) a JSON Lines audit journal for appending
+// filePath: Path of the journal file
+func NewJSONLinesAuditSink(filePath string) (*JSONLinesAuditSink, error) {
+	file, err := os.OpenFile(filePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open audit journal: %w", err)
+	}
+	return &JSONLinesAuditSink{file: file}, nil
+}
+
+// Record appends an entry to the journal
+// entry: Audit entry to write
+func (s *JSONLinesAuditSink) Record(entry AuditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf("failed to encode audit entry: %w", err)
+	}
+	line = append(line, '\n')
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.file.Write(line); err != nil {
+		return fmt.Errorf("failed to write audit entry: %w", err)
+	}
+	return nil
+}
+
+// Close closes the journal file
+func (s *JSONLinesAuditSink) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.file.Close()
+}
+
+// auditDisabledWarning makes sure the missing audit sink warning is only logged once
+var auditDisabledWarning sync.Once
+
+// audit records a mutating call in the bot's audit sink, or warns once if none is configured
+// action: Kind of call being recorded
+// request: Summary of the request parameters
+// ids: IDs returned by or related to the call (trade offer ID, listing ID, ...)
+// err: Error returned by the call, or nil on success
+func (b *Bot) audit(action AuditAction, request map[string]interface{}, ids map[string]string, err error) {
+	if b.Audit == nil {
+		auditDisabledWarning.Do(func() {
+			log.Printf("Audit journal not set; %s and later actions are not recorded\n", action)
+		})
+		return
+	}
+
+	entry := AuditEntry{
+		Time:    time.Now().UTC(),
+		Action:  action,
+		BotID:   b.SteamID,
+		Request: request,
+		Outcome: AuditOutcomeSuccess,
+		IDs:     ids,
+	}
+	if err != nil {
+		entry.Outcome = AuditOutcomeFailure
+		entry.Error = err.Error()
+	}
+
+	if recordErr := b.Audit.Record(entry); recordErr != nil {
+		log.Printf("Failed to record audit entry for %s: %v\n", action, recordErr)
+	}
+}
@@ -0,0 +1,224 @@
+package steam
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+)
+
+// Pricer estimates the value of a marketable item in the smallest currency unit (e.g. cents)
+type Pricer interface {
+	Price(appID int, marketHashName string) (int, error)
+}
+
+// OfferDecision represents the outcome of evaluating a received trade offer
+type OfferDecision int
+
+const (
+	// OfferDecisionNone means the rule does not apply and the next rule should be evaluated
+	OfferDecisionNone OfferDecision = iota
+	OfferDecisionAccept
+	OfferDecisionDecline
+	OfferDecisionHold
+)
+
+func (d OfferDecision) String() string {
+	switch d {
+	case OfferDecisionAccept:
+		return "accept"
+	case OfferDecisionDecline:
+		return "decline"
+	case OfferDecisionHold:
+		return "hold"
+	default:
+		return "none"
+	}
+}
+
+// OfferRule evaluates a received trade offer and returns a decision, or OfferDecisionNone to defer to the next rule
+type OfferRule func(offer *ReceivedTradeOffer) (OfferDecision, error)
+
+// OfferResult represents the outcome of processing a single received trade offer.
+// Err is set if a rule failed to evaluate (the offer is then held) or the accept or decline failed.
+type OfferResult struct {
+	Offer    ReceivedTradeOffer
+	Decision OfferDecision
+	TradeID  string
+	Err      error
+}
+
+// OfferRuleEngine applies rules to received trade offers and accepts, declines or holds them
+type OfferRuleEngine struct {
+	Bot   *Bot
+	Rules []OfferRule
+
+	// OnHold is called for every offer held for manual review
+	OnHold func(offer *ReceivedTradeOffer)
+}
+
+// NewOfferRuleEngine creates a new OfferRuleEngine
+// bot: Bot used to accept, decline and confirm offers
+// rules: Rules evaluated in order; the first decision other than OfferDecisionNone wins
+func NewOfferRuleEngine(bot *Bot, rules ...OfferRule) *OfferRuleEngine {
+	return &OfferRuleEngine{
+		Bot:   bot,
+		Rules: rules,
+	}
+}
+
+// Evaluate runs the rules against an offer and returns the first decision.
+// Offers that no rule decides on, or that a rule fails to evaluate, are held for manual review.
+// offer: Trade offer to evaluate
+func (e *OfferRuleEngine) Evaluate(offer *ReceivedTradeOffer) (OfferDecision, error) {
+	for _, rule := range e.Rules {
+		decision, err := rule(offer)
+		if err != nil {
+			return OfferDecisionHold, err
+		}
+		if decision != OfferDecisionNone {
+			return decision, nil
+		}
+	}
+	return OfferDecisionHold, nil
+}
+
+// ProcessOffers fetches the bot's active received trade offers and acts on each according to the rules
+func (e *OfferRuleEngine) ProcessOffers() ([]OfferResult, error) {
+	offers, err := GetReceivedTradeOffers(e.Bot.APIKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get received trade offers: %w", err)
+	}
+
+	results := make([]OfferResult, 0, len(offers))
+	for i := range offers {
+		results = append(results, e.ProcessOffer(&offers[i]))
+	}
+	return results, nil
+}
+
+// ProcessOffer evaluates a single offer and accepts, declines or holds it
+// offer: Trade offer to process
+func (e *OfferRuleEngine) ProcessOffer(offer *ReceivedTradeOffer) OfferResult {
+	result := OfferResult{Offer: *offer}
+
+	if offer.State != TradeOfferStateActive {
+		result.Decision = OfferDecisionNone
+		return result
+	}
+
+	decision, err := e.Evaluate(offer)
+	if err != nil {
+		log.Printf("Holding trade offer %s, rule evaluation failed: %v\n", offer.TradeOfferID, err)
+		result.Err = fmt.Errorf("rule evaluation failed: %w", err)
+	}
+	result.Decision = decision
+
+	switch decision {
+	case OfferDecisionAccept:
+		accepted, err := e.Bot.AcceptTradeOffer(offer.TradeOfferID, offer.PartnerSteamID())
+		if err != nil {
+			result.Err = err
+			return result
+		}
+		result.TradeID = accepted.TradeID
+		if accepted.NeedsMobileConfirmation {
+			result.Err = e.Bot.AcceptConfirmationForObject(offer.TradeOfferID)
+		}
+	case OfferDecisionDecline:
+		result.Err = e.Bot.DeclineTradeOffer(offer.TradeOfferID)
+	default:
+		if e.OnHold != nil {
+			e.OnHold(offer)
+		}
+	}
+
+	return result
+}
+
+// AcceptGifts accepts offers in which the bot gives nothing away
+func AcceptGifts() OfferRule {
+	return func(offer *ReceivedTradeOffer) (OfferDecision, error) {
+		if len(offer.ItemsToGive) == 0 && len(offer.ItemsToReceive) > 0 {
+			return OfferDecisionAccept, nil
+		}
+		return OfferDecisionNone, nil
+	}
+}
+
+// AcceptFairValue accepts offers in which the value received is at least the value given
+// pricer: Pricer used to value the items on both sides
+func AcceptFairValue(pricer Pricer) OfferRule {
+	return func(offer *ReceivedTradeOffer) (OfferDecision, error) {
+		given, err := offerItemsValue(pricer, offer.ItemsToGive)
+		if err != nil {
+			return OfferDecisionNone, err
+		}
+		received, err := offerItemsValue(pricer, offer.ItemsToReceive)
+		if err != nil {
+			return OfferDecisionNone, err
+		}
+
+		if received >= given {
+			return OfferDecisionAccept, nil
+		}
+		return OfferDecisionNone, nil
+	}
+}
+
+// DeclineBlocklisted declines offers from any of the given users
+// steamIDs: SteamID64s of the blocked users
+func DeclineBlocklisted(steamIDs ...string) OfferRule {
+	blocked := make(map[string]bool, len(steamIDs))
+	for _, steamID := range steamIDs {
+		blocked[steamID] = true
+	}
+
+	return func(offer *ReceivedTradeOffer) (OfferDecision, error) {
+		if blocked[offer.PartnerSteamID()] {
+			return OfferDecisionDecline, nil
+		}
+		return OfferDecisionNone, nil
+	}
+}
+
+// DeclineTradeBanned declines offers from users with a trade (economy) ban or a community ban
+// apiKey: Steam Web API key used to look up bans
+func DeclineTradeBanned(apiKey string) OfferRule {
+	return func(offer *ReceivedTradeOffer) (OfferDecision, error) {
+		bans, err := GetPlayerBans(apiKey, offer.PartnerSteamID())
+		if err != nil {
+			return OfferDecisionNone, fmt.Errorf("failed to get player bans: %w", err)
+		}
+
+		for _, player := range bans.Players {
+			if player.CommunityBanned || (player.EconomyBan != "" && player.EconomyBan != "none") {
+				return OfferDecisionDecline, nil
+			}
+		}
+		return OfferDecisionNone, nil
+	}
+}
+
+// offerItemsValue sums the value of a list of trade offer items
+// pricer: Pricer used to value the items
+// items: Items to value
+func offerItemsValue(pricer Pricer, items []TradeOfferItem) (int, error) {
+	total := 0
+	for _, item := range items {
+		if item.MarketHashName == "" {
+			return 0, fmt.Errorf("item %s has no market hash name", item.AssetID)
+		}
+
+		price, err := pricer.Price(item.AppID, item.MarketHashName)
+		if err != nil {
+			return 0, fmt.Errorf("failed to price %s: %w", item.MarketHashName, err)
+		}
+
+		amount, err := strconv.Atoi(item.Amount)
+		if err != nil || amount < 1 {
+			amount = 1
+		}
+		total += price * amount
+	}
+	return total, nil
+}
@@ -7,16 +7,44 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"sync"
 )
 
 // Bot represents a Steam bot
 type Bot struct {
 	APIKey     string
+	SteamID    string
 	Session    *http.Client
 	SteamGuard *Guard
+
+	// Audit records every state-changing action. NewBot sets it to the shared DefaultAuditJournal;
+	// while it is nil nothing is recorded and a warning is logged.
+	Audit AuditSink
+}
+
+// DefaultAuditJournal is the journal file bots created by NewBot record their actions in
+const DefaultAuditJournal = "audit.jsonl"
+
+var (
+	defaultAuditOnce sync.Once
+	defaultAuditSink AuditSink
+)
+
+// defaultAudit opens DefaultAuditJournal once and returns the sink shared by all bots created by NewBot,
+// or nil if the journal cannot be opened
+func defaultAudit() AuditSink {
+	defaultAuditOnce.Do(func() {
+		sink, err := NewJSONLinesAuditSink(DefaultAuditJournal)
+		if err != nil {
+			log.Printf("Audit journal disabled: %v\n", err)
+			return
+		}
+		defaultAuditSink = sink
+	})
+	return defaultAuditSink
 }
 
-// NewBot creates a new Bot instance
+// NewBot creates a new Bot instance that records its actions in DefaultAuditJournal
 // apiKey: Steam Web API key
 // steamGuard: Steam Guard instance with shared secret for 2FA
 func NewBot(apiKey string, steamGuard *Guard) *Bot {
@@ -24,12 +52,38 @@ func NewBot(apiKey string, steamGuard *Guard) *Bot {
 		APIKey:     apiKey,
 		Session:    &http.Client{},
 		SteamGuard: steamGuard,
+		Audit:      defaultAudit(),
 	}
 }
 
+// NewAuditedBot creates a new Bot instance that records its actions in its own JSON Lines audit journal
+// apiKey: Steam Web API key
+// steamGuard: Steam Guard instance with shared secret for 2FA
+// journalPath: Path of the journal file, or "" for DefaultAuditJournal
+func NewAuditedBot(apiKey string, steamGuard *Guard, journalPath string) (*Bot, error) {
+	if journalPath == "" {
+		journalPath = DefaultAuditJournal
+	}
+	sink, err := NewJSONLinesAuditSink(journalPath)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Bot{
+		APIKey:     apiKey,
+		Session:    &http.Client{},
+		SteamGuard: steamGuard,
+		Audit:      sink,
+	}, nil
+}
+
 // AddFriend sends a friend request to a specified SteamID
 // steamID: SteamID64 of the user to add as a friend
-func (b *Bot) AddFriend(steamID string) error {
+func (b *Bot) AddFriend(steamID string) (err error) {
+	defer func() {
+		b.audit(AuditActionAddFriend, map[string]interface{}{"steamid": steamID}, nil, err)
+	}()
+
 	data := url.Values{}
 	data.Set("sessionid", "your_session_id")
 	data.Set("steamid", steamID)
@@ -62,7 +116,11 @@ func (b *Bot) AddFriend(steamID string) error {
 
 // RemoveFriend removes a friend from the bot's friend list
 // steamID: SteamID64 of the user to remove as a friend
-func (b *Bot) RemoveFriend(steamID string) error {
+func (b *Bot) RemoveFriend(steamID string) (err error) {
+	defer func() {
+		b.audit(AuditActionRemoveFriend, map[string]interface{}{"steamid": steamID}, nil, err)
+	}()
+
 	data := url.Values{}
 	data.Set("sessionid", "your_session_id")
 	data.Set("steamid", steamID)
@@ -95,7 +153,11 @@ func (b *Bot) RemoveFriend(steamID string) error {
 
 // AcceptFriendRequest accepts a friend request from a specified SteamID
 // steamID: SteamID64 of the user whose friend request to accept
-func (b *Bot) AcceptFriendRequest(steamID string) error {
+func (b *Bot) AcceptFriendRequest(steamID string) (err error) {
+	defer func() {
+		b.audit(AuditActionAcceptFriendRequest, map[string]interface{}{"steamid": steamID}, nil, err)
+	}()
+
 	data := url.Values{}
 	data.Set("sessionid", "your_session_id")
 	data.Set("steamid", steamID)
@@ -0,0 +1,212 @@
+package steam
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+)
+
+// PricingStrategy decides the price an item is listed at
+type PricingStrategy interface {
+	Price(item MarketItem) (int, PriceKind, error)
+}
+
+// PricingStrategyFunc adapts an ordinary function to a PricingStrategy
+type PricingStrategyFunc func(item MarketItem) (int, PriceKind, error)
+
+// Price calls f(item)
+func (f PricingStrategyFunc) Price(item MarketItem) (int, PriceKind, error) {
+	return f(item)
+}
+
+// ItemPrice lists every item at its own MarketItem.Price
+// kind: Whether the item prices are buyer prices or seller amounts
+func ItemPrice(kind PriceKind) PricingStrategy {
+	return PricingStrategyFunc(func(item MarketItem) (int, PriceKind, error) {
+		return item.Price, kind, nil
+	})
+}
+
+// FixedPrice lists every item at the same price
+// price: Price in hundredths of the currency unit
+// kind: Whether price is the buyer price or the seller amount
+func FixedPrice(price int, kind PriceKind) PricingStrategy {
+	return PricingStrategyFunc(func(item MarketItem) (int, PriceKind, error) {
+		return price, kind, nil
+	})
+}
+
+// UndercutLowestPrice lists each item just below the current lowest listing price.
+// Items must have MarketHashName set.
+// bot: Bot used to look up price overviews
+// undercut: Amount to undercut the lowest price by, in hundredths
+// minimum: Lowest buyer price the strategy will ever return, in hundredths
+func UndercutLowestPrice(bot *Bot, undercut, minimum int) PricingStrategy {
+	return PricingStrategyFunc(func(item MarketItem) (int, PriceKind, error) {
+		if item.MarketHashName == "" {
+			return 0, 0, fmt.Errorf("item %d has no market hash name", item.AssetID)
+		}
+		overview, err := bot.GetPriceOverview(item.AppID, item.MarketHashName, item.Currency)
+		if err != nil {
+			return 0, 0, err
+		}
+		lowest, err := overview.LowestPriceAmount()
+		if err != nil {
+			return 0, 0, fmt.Errorf("failed to parse lowest price of %s: %w", item.MarketHashName, err)
+		}
+
+		price := lowest - undercut
+		if price < minimum {
+			price = minimum
+		}
+		return price, PriceBuyerPays, nil
+	})
+}
+
+// BulkListResult represents the outcome of listing a single item in a bulk listing
+type BulkListResult struct {
+	Item      MarketItem
+	Price     int
+	Kind      PriceKind
+	Listed    bool
+	Confirmed bool
+	ListingID string
+	Err       error
+
+	needsConfirmation bool
+}
+
+// BulkLister lists many items on the market, pacing the requests and confirming
+// all created listings in a single batch
+type BulkLister struct {
+	Bot      *Bot
+	Strategy PricingStrategy
+
+	// Interval is the delay between consecutive sell requests
+	Interval time.Duration
+	// Confirm makes the lister accept the mobile confirmations of the created listings
+	Confirm bool
+}
+
+// NewBulkLister creates a new BulkLister
+// bot: Bot used to list and confirm items
+// strategy: Pricing strategy deciding each item's price
+func NewBulkLister(bot *Bot, strategy PricingStrategy) *BulkLister {
+	return &BulkLister{
+		Bot:      bot,
+		Strategy: strategy,
+		Interval: 3 * time.Second,
+		Confirm:  true,
+	}
+}
+
+// ListItems lists every item and, if Confirm is set, confirms the created listings in one batch.
+// The returned results are in the same order as items.
+// items: Items to list
+func (l *BulkLister) ListItems(items []MarketItem) []BulkListResult {
+	results := make([]BulkListResult, len(items))
+
+	for i, item := range items {
+		if i > 0 && l.Interval > 0 {
+			time.Sleep(l.Interval)
+		}
+
+		result := &results[i]
+		result.Item = item
+
+		price, kind, err := l.Strategy.Price(item)
+		if err != nil {
+			result.Err = fmt.Errorf("failed to price item: %w", err)
+			continue
+		}
+		result.Price = price
+		result.Kind = kind
+
+		item.Price = price
+		listed, err := l.Bot.listMarketItem(item, kind)
+		if err != nil {
+			result.Err = err
+			continue
+		}
+		result.Listed = true
+		result.needsConfirmation = listed.RequiresConfirmation != 0 || listed.NeedsMobileConfirmation
+	}
+
+	if l.Confirm {
+		if err := l.confirmListings(results); err != nil {
+			log.Printf("Failed to confirm bulk listings: %v\n", err)
+			for i := range results {
+				if results[i].needsConfirmation && !results[i].Confirmed && results[i].Err == nil {
+					results[i].Err = fmt.Errorf("listing created but not confirmed: %w", err)
+				}
+			}
+		}
+	}
+
+	return results
+}
+
+// confirmListings matches the pending listings to their mobile confirmations and accepts them together
+// results: Results of the sell requests
+func (l *BulkLister) confirmListings(results []BulkListResult) error {
+	// Several units of one commodity stack are listed from the same asset, so each asset
+	// keeps a queue of its pending results in listing order
+	pending := make(map[string][]*BulkListResult)
+	var unconfirmed []*BulkListResult
+	for i := range results {
+		if results[i].needsConfirmation {
+			assetID := strconv.Itoa(results[i].Item.AssetID)
+			pending[assetID] = append(pending[assetID], &results[i])
+			unconfirmed = append(unconfirmed, &results[i])
+		}
+	}
+	if len(unconfirmed) == 0 {
+		return nil
+	}
+
+	// Listings awaiting confirmation carry the asset ID that links them to our results
+	listings, err := l.Bot.GetMyListings(0, 100)
+	if err != nil {
+		return err
+	}
+	byListingID := make(map[string]*BulkListResult)
+	for _, listing := range listings.ListingsToConfirm {
+		queue := pending[listing.Asset.ID]
+		if len(queue) == 0 {
+			continue
+		}
+		result := queue[0]
+		pending[listing.Asset.ID] = queue[1:]
+		result.ListingID = listing.ListingID
+		byListingID[listing.ListingID] = result
+	}
+
+	confirmations, err := l.Bot.GetConfirmations()
+	if err != nil {
+		return err
+	}
+	var batch []Confirmation
+	for _, confirmation := range confirmations {
+		if confirmation.Type != ConfirmationTypeMarketListing {
+			continue
+		}
+		if _, ok := byListingID[confirmation.CreatorID]; ok {
+			batch = append(batch, confirmation)
+		}
+	}
+
+	if err := l.Bot.RespondToConfirmations(batch, true); err != nil {
+		return err
+	}
+	for _, confirmation := range batch {
+		byListingID[confirmation.CreatorID].Confirmed = true
+	}
+
+	for _, result := range unconfirmed {
+		if !result.Confirmed {
+			result.Err = fmt.Errorf("listing created but no matching confirmation was found")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,168 @@
+package steam
+
+import (
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// ConfirmationType represents the kind of action a mobile confirmation is for
+type ConfirmationType int
+
+const (
+	ConfirmationTypeGeneric       ConfirmationType = 1
+	ConfirmationTypeTrade         ConfirmationType = 2
+	ConfirmationTypeMarketListing ConfirmationType = 3
+)
+
+// Confirmation represents a pending Steam mobile confirmation
+type Confirmation struct {
+	Type         ConfirmationType `json:"type"`
+	TypeName     string           `json:"type_name"`
+	ID           string           `json:"id"`
+	CreatorID    string           `json:"creator_id"`
+	Nonce        string           `json:"nonce"`
+	CreationTime int64            `json:"creation_time"`
+	Headline     string           `json:"headline"`
+	Summary      []string         `json:"summary"`
+}
+
+// confirmationsResponse represents the response from the mobileconf/getlist call
+type confirmationsResponse struct {
+	Success  bool           `json:"success"`
+	NeedAuth bool           `json:"needauth"`
+	Message  string         `json:"message"`
+	Conf     []Confirmation `json:"conf"`
+}
+
+// confirmationParams builds the query parameters shared by all mobile confirmation calls
+// tag: Confirmation tag ("list", "allow" or "cancel")
+func (b *Bot) confirmationParams(tag string) (url.Values, error) {
+	if b.SteamGuard == nil || b.SteamGuard.IdentitySecret == "" {
+		return nil, fmt.Errorf("identity secret is not set")
+	}
+	if b.SteamID == "" {
+		return nil, fmt.Errorf("bot SteamID is not set")
+	}
+
+	now := time.Now().Unix()
+	key, err := GenerateConfirmationKey(b.SteamGuard.IdentitySecret, tag, now)
+	if err != nil {
+		return nil, err
+	}
+
+	deviceID := b.SteamGuard.DeviceID
+	if deviceID == "" {
+		deviceID = GenerateDeviceID(b.SteamID)
+	}
+
+	params := url.Values{}
+	params.Set("p", deviceID)
+	params.Set("a", b.SteamID)
+	params.Set("k", key)
+	params.Set("t", strconv.FormatInt(now, 10))
+	params.Set("m", "react")
+	params.Set("tag", tag)
+	return params, nil
+}
+
+// GetConfirmations fetches the list of pending mobile confirmations
+func (b *Bot) GetConfirmations() ([]Confirmation, error) {
+	params, err := b.confirmationParams("list")
+	if err != nil {
+		return nil, err
+	}
+
+	var result confirmationsResponse
+	err = getJSON(b.Session, "https://steamcommunity.com/mobileconf/getlist?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.NeedAuth {
+		return nil, fmt.Errorf("confirmations require authentication")
+	}
+	if !result.Success {
+		return nil, fmt.Errorf("fetching confirmations failed: %s", result.Message)
+	}
+
+	return result.Conf, nil
+}
+
+// RespondToConfirmation accepts or cancels a single mobile confirmation
+// confirmation: Confirmation to respond to
+// accept: true to accept the confirmation, false to cancel it
+func (b *Bot) RespondToConfirmation(confirmation Confirmation, accept bool) error {
+	return b.RespondToConfirmations([]Confirmation{confirmation}, accept)
+}
+
+// RespondToConfirmations accepts or cancels several mobile confirmations in one batch
+// confirmations: Confirmations to respond to
+// accept: true to accept the confirmations, false to cancel them
+func (b *Bot) RespondToConfirmations(confirmations []Confirmation, accept bool) (err error) {
+	if len(confirmations) == 0 {
+		return nil
+	}
+
+	defer func() {
+		for _, confirmation := range confirmations {
+			b.audit(AuditActionConfirmation, map[string]interface{}{
+				"accept": accept,
+				"type":   confirmation.Type,
+			}, map[string]string{
+				"confirmation_id": confirmation.ID,
+				"creator_id":      confirmation.CreatorID,
+			}, err)
+		}
+	}()
+
+	op := "cancel"
+	if accept {
+		op = "allow"
+	}
+
+	params, err := b.confirmationParams(op)
+	if err != nil {
+		return err
+	}
+	params.Set("op", op)
+	for _, confirmation := range confirmations {
+		params.Add("cid[]", confirmation.ID)
+		params.Add("ck[]", confirmation.Nonce)
+	}
+
+	var result struct {
+		Success bool   `json:"success"`
+		Message string `json:"message"`
+	}
+	err = postForm(b.Session, "https://steamcommunity.com/mobileconf/multiajaxop", params, &result)
+	if err != nil {
+		return err
+	}
+
+	if !result.Success {
+		return fmt.Errorf("responding to confirmations failed: %s", result.Message)
+	}
+
+	log.Printf("Responded to %d confirmations (op=%s)\n", len(confirmations), op)
+	return nil
+}
+
+// AcceptConfirmationForObject accepts the pending confirmation created for a trade offer or market listing
+// creatorID: ID of the trade offer or market listing that requires confirmation
+func (b *Bot) AcceptConfirmationForObject(creatorID string) error {
+	confirmations, err := b.GetConfirmations()
+	if err != nil {
+		return err
+	}
+
+	for _, confirmation := range confirmations {
+		if confirmation.CreatorID == creatorID {
+			return b.RespondToConfirmation(confirmation, true)
+		}
+	}
+
+	return fmt.Errorf("no confirmation found for object %s", creatorID)
+}
@@ -0,0 +1,299 @@
+package steam
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Currency represents a Steam wallet currency (ECurrencyCode).
+// Market amounts are always expressed in hundredths of the currency unit, even for
+// currencies that Steam displays without decimals.
+type Currency int
+
+const (
+	CurrencyUSD Currency = 1
+	CurrencyGBP Currency = 2
+	CurrencyEUR Currency = 3
+	CurrencyCHF Currency = 4
+	CurrencyRUB Currency = 5
+	CurrencyPLN Currency = 6
+	CurrencyBRL Currency = 7
+	CurrencyJPY Currency = 8
+	CurrencyNOK Currency = 9
+	CurrencyIDR Currency = 10
+	CurrencyMYR Currency = 11
+	CurrencyPHP Currency = 12
+	CurrencySGD Currency = 13
+	CurrencyTHB Currency = 14
+	CurrencyVND Currency = 15
+	CurrencyKRW Currency = 16
+	CurrencyTRY Currency = 17
+	CurrencyUAH Currency = 18
+	CurrencyMXN Currency = 19
+	CurrencyCAD Currency = 20
+	CurrencyAUD Currency = 21
+	CurrencyNZD Currency = 22
+	CurrencyCNY Currency = 23
+	CurrencyINR Currency = 24
+	CurrencyCLP Currency = 25
+	CurrencyPEN Currency = 26
+	CurrencyCOP Currency = 27
+	CurrencyZAR Currency = 28
+	CurrencyHKD Currency = 29
+	CurrencyTWD Currency = 30
+	CurrencySAR Currency = 31
+	CurrencyAED Currency = 32
+	CurrencySEK Currency = 33
+	CurrencyARS Currency = 34
+	CurrencyILS Currency = 35
+	CurrencyBYN Currency = 36
+	CurrencyKZT Currency = 37
+	CurrencyKWD Currency = 38
+	CurrencyQAR Currency = 39
+	CurrencyCRC Currency = 40
+	CurrencyUYU Currency = 41
+	CurrencyBGN Currency = 42
+	CurrencyHRK Currency = 43
+	CurrencyCZK Currency = 44
+	CurrencyDKK Currency = 45
+	CurrencyHUF Currency = 46
+	CurrencyRON Currency = 47
+)
+
+// currencyInfo describes how Steam formats a currency
+type currencyInfo struct {
+	iso        string
+	prefix     string
+	suffix     string
+	decimalSep string
+	precision  int
+	aliases    []string
+}
+
+// currencies holds the formatting details of every Steam currency
+var currencies = map[Currency]currencyInfo{
+	CurrencyUSD: {"USD", "$", "", ".", 2, nil},
+	CurrencyGBP: {"GBP", "£", "", ".", 2, nil},
+	CurrencyEUR: {"EUR", "", "€", ",", 2, nil},
+	CurrencyCHF: {"CHF", "CHF ", "", ".", 2, nil},
+	CurrencyRUB: {"RUB", "", " pуб.", ",", 2, []string{"руб.", "₽"}},
+	CurrencyPLN: {"PLN", "", "zł", ",", 2, nil},
+	CurrencyBRL: {"BRL", "R$ ", "", ",", 2, nil},
+	CurrencyJPY: {"JPY", "¥ ", "", ".", 0, nil},
+	CurrencyNOK: {"NOK", "", " kr", ",", 2, nil},
+	CurrencyIDR: {"IDR", "Rp ", "", ".", 0, nil},
+	CurrencyMYR: {"MYR", "RM", "", ".", 2, nil},
+	CurrencyPHP: {"PHP", "P", "", ".", 2, []string{"₱"}},
+	CurrencySGD: {"SGD", "S$", "", ".", 2, nil},
+	CurrencyTHB: {"THB", "฿", "", ".", 2, nil},
+	CurrencyVND: {"VND", "", "₫", ",", 0, nil},
+	CurrencyKRW: {"KRW", "₩ ", "", ".", 0, nil},
+	CurrencyTRY: {"TRY", "", " TL", ",", 2, []string{"₺"}},
+	CurrencyUAH: {"UAH", "", "₴", ",", 0, nil},
+	CurrencyMXN: {"MXN", "Mex$ ", "", ".", 2, nil},
+	CurrencyCAD: {"CAD", "CDN$ ", "", ".", 2, nil},
+	CurrencyAUD: {"AUD", "A$ ", "", ".", 2, nil},
+	CurrencyNZD: {"NZD", "NZ$ ", "", ".", 2, nil},
+	CurrencyCNY: {"CNY", "¥ ", "", ".", 2, nil},
+	CurrencyINR: {"INR", "₹ ", "", ".", 0, nil},
+	CurrencyCLP: {"CLP", "CLP$ ", "", ",", 0, nil},
+	CurrencyPEN: {"PEN", "S/.", "", ".", 2, nil},
+	CurrencyCOP: {"COP", "COL$ ", "", ",", 0, nil},
+	CurrencyZAR: {"ZAR", "R ", "", ".", 2, nil},
+	CurrencyHKD: {"HKD", "HK$ ", "", ".", 2, nil},
+	CurrencyTWD: {"TWD", "NT$ ", "", ".", 0, nil},
+	CurrencySAR: {"SAR", "", " SR", ".", 2, nil},
+	CurrencyAED: {"AED", "", " AED", ".", 2, nil},
+	CurrencySEK: {"SEK", "", " kr", ",", 2, nil},
+	CurrencyARS: {"ARS", "ARS$ ", "", ",", 2, nil},
+	CurrencyILS: {"ILS", "₪", "", ".", 2, nil},
+	CurrencyBYN: {"BYN", "Br", "", ",", 2, nil},
+	CurrencyKZT: {"KZT", "", "₸", ",", 0, nil},
+	CurrencyKWD: {"KWD", "", " KD", ".", 2, nil},
+	CurrencyQAR: {"QAR", "", " QR", ".", 2, nil},
+	CurrencyCRC: {"CRC", "₡", "", ",", 0, nil},
+	CurrencyUYU: {"UYU", "$U", "", ",", 0, nil},
+	CurrencyBGN: {"BGN", "", " лв", ",", 2, nil},
+	CurrencyHRK: {"HRK", "", " kn", ",", 2, nil},
+	CurrencyCZK: {"CZK", "", " Kč", ",", 2, nil},
+	CurrencyDKK: {"DKK", "", " kr.", ",", 2, nil},
+	CurrencyHUF: {"HUF", "", " Ft", ",", 0, nil},
+	CurrencyRON: {"RON", "", " lei", ",", 2, nil},
+}
+
+// currencySymbol maps a currency symbol to the currency it identifies
+type currencySymbol struct {
+	symbol   string
+	currency Currency
+}
+
+// currencySymbols lists every symbol, longest first so that e.g. "CDN$" is matched before "$".
+// Symbols shared by several currencies (such as "¥" or "kr") resolve to the lowest currency code.
+var currencySymbols = func() []currencySymbol {
+	seen := make(map[string]bool)
+	var symbols []currencySymbol
+
+	codes := make([]int, 0, len(currencies))
+	for c := range currencies {
+		codes = append(codes, int(c))
+	}
+	sort.Ints(codes)
+
+	for _, code := range codes {
+		info := currencies[Currency(code)]
+		for _, symbol := range append([]string{info.prefix, info.suffix}, info.aliases...) {
+			symbol = strings.TrimSpace(symbol)
+			if symbol == "" || seen[symbol] {
+				continue
+			}
+			seen[symbol] = true
+			symbols = append(symbols, currencySymbol{symbol, Currency(code)})
+		}
+	}
+
+	sort.SliceStable(symbols, func(i, j int) bool {
+		return len(symbols[i].symbol) > len(symbols[j].symbol)
+	})
+	return symbols
+}()
+
+// Code returns the numeric Steam currency code
+func (c Currency) Code() int {
+	return int(c)
+}
+
+// ISO returns the ISO 4217 code of the currency, e.g. "USD"
+func (c Currency) ISO() string {
+	return currencies[c].iso
+}
+
+// Precision returns the number of decimals Steam displays for the currency
+func (c Currency) Precision() int {
+	return currencies[c].precision
+}
+
+// Valid reports whether c is a known Steam currency
+func (c Currency) Valid() bool {
+	_, ok := currencies[c]
+	return ok
+}
+
+func (c Currency) String() string {
+	if !c.Valid() {
+		return fmt.Sprintf("Currency(%d)", int(c))
+	}
+	return c.ISO()
+}
+
+// ParseCurrency returns the currency with the given ISO 4217 code
+// iso: ISO 4217 code, e.g. "EUR"
+func ParseCurrency(iso string) (Currency, error) {
+	iso = strings.ToUpper(strings.TrimSpace(iso))
+	for c, info := range currencies {
+		if info.iso == iso {
+			return c, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown currency: %s", iso)
+}
+
+// FormatPrice formats an amount in hundredths the way Steam displays it, e.g. "1,23€"
+// amount: Amount in hundredths of the currency unit
+func (c Currency) FormatPrice(amount int) string {
+	info, ok := currencies[c]
+	if !ok {
+		return strconv.Itoa(amount)
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	number := strconv.Itoa(amount / 100)
+	if info.precision > 0 {
+		number += info.decimalSep + fmt.Sprintf("%02d", amount%100)
+	}
+
+	return sign + info.prefix + number + info.suffix
+}
+
+// ParsePrice parses a price formatted by Steam in this currency (e.g. "1,23€" or "$4.56") into hundredths
+// s: Formatted price
+func (c Currency) ParsePrice(s string) (int, error) {
+	return parsePriceAmount(s, currencies[c].decimalSep)
+}
+
+// ParsePrice parses a price formatted by Steam, detecting the currency from its symbol.
+// Use Currency.ParsePrice when the currency is known, as some symbols are shared.
+// s: Formatted price, e.g. "1,23€", "$4.56" or "₽ 100"
+func ParsePrice(s string) (int, Currency, error) {
+	var currency Currency
+	for _, symbol := range currencySymbols {
+		if strings.Contains(s, symbol.symbol) {
+			currency = symbol.currency
+			break
+		}
+	}
+	if currency == 0 {
+		return 0, 0, fmt.Errorf("unknown currency in price %q", s)
+	}
+
+	amount, err := currency.ParsePrice(s)
+	if err != nil {
+		return 0, 0, err
+	}
+	return amount, currency, nil
+}
+
+// parsePriceAmount extracts the numeric part of a formatted price and returns it in hundredths.
+// The last occurrence of decimalSep is treated as the decimal separator when followed by one or
+// two digits; every other separator is a thousands separator. An empty decimalSep accepts either.
+// s: Formatted price
+// decimalSep: Decimal separator of the currency, "." or ","
+func parsePriceAmount(s, decimalSep string) (int, error) {
+	// Steam writes whole amounts in some currencies as "1,--€"
+	s = strings.ReplaceAll(s, "--", "00")
+
+	var b strings.Builder
+	for _, r := range s {
+		if (r >= '0' && r <= '9') || r == '.' || r == ',' {
+			b.WriteRune(r)
+		}
+	}
+	number := strings.Trim(b.String(), ".,")
+	if number == "" {
+		return 0, fmt.Errorf("invalid price %q", s)
+	}
+
+	separators := decimalSep
+	if separators == "" {
+		separators = ".,"
+	}
+
+	whole, fraction := number, ""
+	if i := strings.LastIndexAny(number, separators); i >= 0 && len(number)-i-1 <= 2 {
+		whole, fraction = number[:i], number[i+1:]
+	}
+	whole = strings.NewReplacer(".", "", ",", "").Replace(whole)
+	for len(fraction) < 2 {
+		fraction += "0"
+	}
+
+	if whole == "" {
+		whole = "0"
+	}
+	units, err := strconv.Atoi(whole)
+	if err != nil {
+		return 0, fmt.Errorf("invalid price %q: %w", s, err)
+	}
+	cents, err := strconv.Atoi(fraction)
+	if err != nil {
+		return 0, fmt.Errorf("invalid price %q: %w", s, err)
+	}
+
+	return units*100 + cents, nil
+}
@@ -0,0 +1,51 @@
+package steam
+
+import "testing"
+
+func TestParsePriceAmount(t *testing.T) {
+	tests := []struct {
+		s          string
+		decimalSep string
+		want       int
+	}{
+		{"$4.56", ".", 456},
+		{"$5", ".", 500},
+		{"$1,234.56", ".", 123456},
+		{"1,23€", ",", 123},
+		{"1,--€", ",", 100},
+		{"1.234,56€", ",", 123456},
+		{"1 234,5 pуб.", ",", 123450},
+		{"¥ 1,234", ".", 123400},
+		{"1,5", "", 150},
+		{"1.25", "", 125},
+	}
+
+	for _, tt := range tests {
+		got, err := parsePriceAmount(tt.s, tt.decimalSep)
+		if err != nil {
+			t.Errorf("parsePriceAmount(%q, %q) returned error: %v", tt.s, tt.decimalSep, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parsePriceAmount(%q, %q) = %d, want %d", tt.s, tt.decimalSep, got, tt.want)
+		}
+	}
+}
+
+func TestParsePriceAmountInvalid(t *testing.T) {
+	for _, s := range []string{"", "$", "pуб."} {
+		if _, err := parsePriceAmount(s, "."); err == nil {
+			t.Errorf("parsePriceAmount(%q) did not return an error", s)
+		}
+	}
+}
+
+func TestParsePrice(t *testing.T) {
+	amount, currency, err := ParsePrice("1,23€")
+	if err != nil {
+		t.Fatalf("ParsePrice returned error: %v", err)
+	}
+	if amount != 123 || currency != CurrencyEUR {
+		t.Errorf("ParsePrice(\"1,23€\") = %d %s, want 123 EUR", amount, currency)
+	}
+}
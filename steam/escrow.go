@@ -0,0 +1,142 @@
+package steam
+
+import (
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+)
+
+// ErrTradeHoldExceeded is returned when a trade offer would be held for longer than allowed
+var ErrTradeHoldExceeded = errors.New("trade hold exceeds the allowed duration")
+
+// TradeHoldDurations represents the escrow (trade hold) durations for a trade with a partner
+type TradeHoldDurations struct {
+	MyEscrowDays    int `json:"my_escrow_days"`
+	TheirEscrowDays int `json:"their_escrow_days"`
+}
+
+// MaxDays returns the longest of the two escrow durations
+func (d *TradeHoldDurations) MaxDays() int {
+	if d.MyEscrowDays > d.TheirEscrowDays {
+		return d.MyEscrowDays
+	}
+	return d.TheirEscrowDays
+}
+
+// tradeHoldDurationsResponse represents the response from the GetTradeHoldDurations API call.
+// Steam answers {"response":{}} when it cannot tell, so missing fields are kept distinguishable from 0.
+type tradeHoldDurationsResponse struct {
+	Response struct {
+		MyEscrow    *escrowDuration `json:"my_escrow"`
+		TheirEscrow *escrowDuration `json:"their_escrow"`
+	} `json:"response"`
+}
+
+// escrowDuration represents one side's escrow in the GetTradeHoldDurations response
+type escrowDuration struct {
+	EscrowEndDurationSeconds *int `json:"escrow_end_duration_seconds"`
+}
+
+// days returns the escrow duration rounded up to whole days, or false if Steam did not report it
+func (e *escrowDuration) days() (int, bool) {
+	if e == nil || e.EscrowEndDurationSeconds == nil {
+		return 0, false
+	}
+	return (*e.EscrowEndDurationSeconds + 86399) / 86400, true
+}
+
+var (
+	myEscrowDaysRegexp    = regexp.MustCompile(`var g_daysMyEscrow\s*=\s*(\d+);`)
+	theirEscrowDaysRegexp = regexp.MustCompile(`var g_daysTheirEscrow\s*=\s*(\d+);`)
+)
+
+// GetTradeHoldDurations fetches the trade hold durations for a trade with a partner from the Steam API.
+// Partial days are rounded up, and a response without durations is an error.
+// apiKey: Steam Web API key
+// steamID: SteamID64 of the trade partner
+// accessToken: Partner's trade offer access token, required if the partner is not a friend
+func GetTradeHoldDurations(apiKey, steamID, accessToken string) (*TradeHoldDurations, error) {
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("key", apiKey)
+	params.Set("steamid_target", steamID)
+	if accessToken != "" {
+		params.Set("trade_offer_access_token", accessToken)
+	}
+
+	var result tradeHoldDurationsResponse
+	err := getJSON(http.DefaultClient, "https://api.steampowered.com/IEconService/GetTradeHoldDurations/v1/?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	myDays, ok := result.Response.MyEscrow.days()
+	if !ok {
+		return nil, fmt.Errorf("trade hold durations missing from response; is the access token valid?")
+	}
+	theirDays, ok := result.Response.TheirEscrow.days()
+	if !ok {
+		return nil, fmt.Errorf("trade hold durations missing from response; is the access token valid?")
+	}
+
+	return &TradeHoldDurations{
+		MyEscrowDays:    myDays,
+		TheirEscrowDays: theirDays,
+	}, nil
+}
+
+// GetTradeHoldDurations fetches the trade hold durations for a trade with a partner,
+// falling back to scraping the new trade offer page if the Steam API call fails
+// partnerSteamID: SteamID64 of the trade partner
+// accessToken: Partner's trade offer access token, required if the partner is not a friend
+func (b *Bot) GetTradeHoldDurations(partnerSteamID, accessToken string) (*TradeHoldDurations, error) {
+	durations, err := GetTradeHoldDurations(b.APIKey, partnerSteamID, accessToken)
+	if err == nil {
+		return durations, nil
+	}
+
+	log.Printf("GetTradeHoldDurations API call failed, falling back to trade offer page: %v\n", err)
+	return b.scrapeTradeHoldDurations(partnerSteamID, accessToken)
+}
+
+// scrapeTradeHoldDurations reads the trade hold durations from the new trade offer page
+// partnerSteamID: SteamID64 of the trade partner
+// accessToken: Partner's trade offer access token, required if the partner is not a friend
+func (b *Bot) scrapeTradeHoldDurations(partnerSteamID, accessToken string) (*TradeHoldDurations, error) {
+	accountID, err := SteamIDToAccountID(partnerSteamID)
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("partner", strconv.FormatUint(uint64(accountID), 10))
+	if accessToken != "" {
+		params.Set("token", accessToken)
+	}
+
+	rateLimiter.Wait()
+
+	body, err := getBody(b.Session, "https://steamcommunity.com/tradeoffer/new/?"+params.Encode())
+	if err != nil {
+		return nil, err
+	}
+
+	myMatch := myEscrowDaysRegexp.FindSubmatch(body)
+	theirMatch := theirEscrowDaysRegexp.FindSubmatch(body)
+	if myMatch == nil || theirMatch == nil {
+		return nil, fmt.Errorf("trade hold durations not found on trade offer page")
+	}
+
+	myDays, _ := strconv.Atoi(string(myMatch[1]))
+	theirDays, _ := strconv.Atoi(string(theirMatch[1]))
+
+	return &TradeHoldDurations{
+		MyEscrowDays:    myDays,
+		TheirEscrowDays: theirDays,
+	}, nil
+}
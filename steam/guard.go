@@ -4,6 +4,7 @@ import (
 	"crypto/hmac"
 	"crypto/sha1"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"time"
 )
@@ -12,6 +13,7 @@ import (
 type Guard struct {
 	SharedSecret   string `json:"shared_secret"`
 	IdentitySecret string `json:"identity_secret"`
+	DeviceID       string `json:"device_id"`
 }
 
 // GenerateSteamGuardCode generates a Steam Guard code
@@ -60,7 +62,7 @@ func GenerateSteamGuardCode(sharedSecret string) (string, error) {
 // identitySecret: Identity secret for Steam Guard
 // tag: Confirmation tag (e.g., "conf" for trade confirmations)
 // time: Current Unix time
-func _(identitySecret, tag string, time int64) (string, error) {
+func GenerateConfirmationKey(identitySecret, tag string, time int64) (string, error) {
 	timeBytes := make([]byte, 8)
 	for i := 7; i >= 0; i-- {
 		timeBytes[i] = byte(time & 0xFF)
@@ -79,3 +81,11 @@ func _(identitySecret, tag string, time int64) (string, error) {
 
 	return base64.StdEncoding.EncodeToString(hmacBytes), nil
 }
+
+// GenerateDeviceID generates the mobile authenticator device ID for a SteamID
+// steamID: SteamID64 of the account
+func GenerateDeviceID(steamID string) string {
+	hash := sha1.Sum([]byte(steamID))
+	h := hex.EncodeToString(hash[:])
+	return fmt.Sprintf("android:%s-%s-%s-%s-%s", h[0:8], h[8:12], h[12:16], h[16:20], h[20:32])
+}
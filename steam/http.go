@@ -0,0 +1,93 @@
+package steam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+// getBody performs a GET request and returns the raw response body
+// client: HTTP client used to send the request
+// rawURL: URL to fetch
+func getBody(client *http.Client, rawURL string) ([]byte, error) {
+	resp, err := client.Get(rawURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to make HTTP request: %w", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+
+		}
+	}(resp.Body)
+
+	// Check for non-OK HTTP status code
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("steam API returned non-OK status: %s", resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	return body, nil
+}
+
+// getJSON performs a GET request and decodes the JSON response into v
+// client: HTTP client used to send the request
+// rawURL: URL to fetch
+// v: Pointer to the value the response is decoded into
+func getJSON(client *http.Client, rawURL string, v interface{}) error {
+	body, err := getBody(client, rawURL)
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("failed to decode JSON response: %w", err)
+	}
+
+	return nil
+}
+
+// postForm sends a form-encoded POST request and decodes the JSON response into v
+// client: HTTP client used to send the request
+// rawURL: URL to post to
+// data: Form values to send
+// v: Pointer to the value the response is decoded into, or nil to discard it
+func postForm(client *http.Client, rawURL string, data url.Values, v interface{}) error {
+	req, err := http.NewRequest("POST", rawURL, bytes.NewBufferString(data.Encode()))
+	if err != nil {
+		return fmt.Errorf("failed to create request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send request: %w", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+
+		}
+	}(resp.Body)
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("steam API returned non-OK status: %s", resp.Status)
+	}
+
+	if v == nil {
+		return nil
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(v); err != nil {
+		return fmt.Errorf("failed to decode JSON response: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,313 @@
+package steam
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"regexp"
+	"strings"
+)
+
+// inspectAction is the console command inspect links run
+const inspectAction = "csgo_econ_action_preview"
+
+// classicInspectRegexp matches the S/M-A-D parameters of inspect links that need a game coordinator lookup
+var classicInspectRegexp = regexp.MustCompile(`^(?:S(\d+)|M(\d+))A(\d+)D(\d+)$`)
+
+// maskedInspectRegexp matches the hex payload of self-contained inspect links
+var maskedInspectRegexp = regexp.MustCompile(`^[0-9A-Fa-f]+$`)
+
+// ErrInspectResolverRequired is returned when a link that needs a game coordinator lookup is resolved without a resolver
+var ErrInspectResolverRequired = errors.New("inspect link requires a resolver")
+
+// InspectLink represents a parsed CS inspect link.
+// Masked links carry the encoded item data themselves; the others carry the IDs a resolver needs.
+type InspectLink struct {
+	Raw             string
+	Masked          bool
+	Data            string
+	OwnerSteamID    string
+	MarketListingID string
+	AssetID         string
+	D               string
+}
+
+// InspectResolver looks up the properties of items behind links that are not self-contained,
+// usually through a game coordinator connection or an inspect service
+type InspectResolver interface {
+	Resolve(link *InspectLink) (*ItemPreviewData, error)
+}
+
+// ItemSticker represents a sticker or keychain applied to an item
+type ItemSticker struct {
+	Slot      uint32  `json:"slot"`
+	StickerID uint32  `json:"sticker_id"`
+	Wear      float32 `json:"wear"`
+	Scale     float32 `json:"scale"`
+	Rotation  float32 `json:"rotation"`
+	TintID    uint32  `json:"tint_id"`
+	OffsetX   float32 `json:"offset_x"`
+	OffsetY   float32 `json:"offset_y"`
+	OffsetZ   float32 `json:"offset_z"`
+	Pattern   uint32  `json:"pattern"`
+}
+
+// ItemPreviewData represents the item properties encoded in a CEconItemPreviewDataBlock
+type ItemPreviewData struct {
+	AccountID          uint32        `json:"accountid"`
+	ItemID             uint64        `json:"itemid"`
+	DefIndex           uint32        `json:"defindex"`
+	PaintIndex         uint32        `json:"paintindex"`
+	Rarity             uint32        `json:"rarity"`
+	Quality            uint32        `json:"quality"`
+	PaintWear          float32       `json:"paintwear"`
+	PaintSeed          uint32        `json:"paintseed"`
+	KillEaterScoreType uint32        `json:"killeaterscoretype"`
+	KillEaterValue     uint32        `json:"killeatervalue"`
+	CustomName         string        `json:"customname"`
+	Stickers           []ItemSticker `json:"stickers"`
+	Inventory          uint32        `json:"inventory"`
+	Origin             uint32        `json:"origin"`
+	QuestID            uint32        `json:"questid"`
+	DropReason         uint32        `json:"dropreason"`
+	MusicIndex         uint32        `json:"musicindex"`
+	EntIndex           int32         `json:"entindex"`
+	PetIndex           uint32        `json:"petindex"`
+	Keychains          []ItemSticker `json:"keychains"`
+}
+
+// InspectLink returns the item's inspect link with the owner and asset placeholders filled in,
+// or "" if the item cannot be inspected
+// ownerSteamID: SteamID64 of the inventory owner
+func (item *InventoryItem) InspectLink(ownerSteamID string) string {
+	actions := append(append([]MarketAction(nil), item.Actions...), item.MarketActions...)
+	for _, action := range actions {
+		if strings.Contains(action.Link, inspectAction) {
+			link := strings.ReplaceAll(action.Link, "%owner_steamid%", ownerSteamID)
+			return strings.ReplaceAll(link, "%assetid%", item.AssetID)
+		}
+	}
+	return ""
+}
+
+// ParseInspectLink parses a CS inspect link, either a masked self-contained link or an S/M-A-D link
+// link: Inspect link with its placeholders already filled in
+func ParseInspectLink(link string) (*InspectLink, error) {
+	index := strings.Index(link, inspectAction)
+	if index < 0 {
+		return nil, fmt.Errorf("not an inspect link: %s", link)
+	}
+	params := link[index+len(inspectAction):]
+	params = strings.TrimPrefix(strings.TrimPrefix(params, "%20"), " ")
+
+	if match := classicInspectRegexp.FindStringSubmatch(params); match != nil {
+		return &InspectLink{
+			Raw:             link,
+			OwnerSteamID:    match[1],
+			MarketListingID: match[2],
+			AssetID:         match[3],
+			D:               match[4],
+		}, nil
+	}
+	if strings.Contains(params, "%") {
+		return nil, fmt.Errorf("inspect link has unfilled placeholders: %s", link)
+	}
+	if maskedInspectRegexp.MatchString(params) {
+		return &InspectLink{Raw: link, Masked: true, Data: params}, nil
+	}
+	return nil, fmt.Errorf("unrecognized inspect link parameters: %s", params)
+}
+
+// Decode decodes a masked inspect link; other links must be resolved with an InspectResolver
+func (l *InspectLink) Decode() (*ItemPreviewData, error) {
+	if !l.Masked {
+		return nil, ErrInspectResolverRequired
+	}
+	return DecodeInspectData(l.Data)
+}
+
+// Resolve decodes a masked inspect link locally, or looks up any other link through resolver
+// resolver: Resolver for links that are not self-contained; may be nil if only masked links are expected
+func (l *InspectLink) Resolve(resolver InspectResolver) (*ItemPreviewData, error) {
+	if l.Masked {
+		return l.Decode()
+	}
+	if resolver == nil {
+		return nil, ErrInspectResolverRequired
+	}
+	return resolver.Resolve(l)
+}
+
+// DecodeInspectData decodes the hex payload of a masked inspect link.
+// The payload is a zero byte, the protobuf-encoded item and a checksum, all XORed with its first byte.
+// data: Hex payload of the link
+func DecodeInspectData(data string) (*ItemPreviewData, error) {
+	buf, err := hex.DecodeString(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode inspect data: %w", err)
+	}
+	if len(buf) < 6 {
+		return nil, fmt.Errorf("inspect data too short: %d bytes", len(buf))
+	}
+
+	key := buf[0]
+	for i := range buf {
+		buf[i] ^= key
+	}
+
+	payload := buf[:len(buf)-4]
+	proto := payload[1:]
+	crc := crc32.ChecksumIEEE(payload)
+	checksum := (crc & 0xffff) ^ (uint32(len(proto)) * crc)
+	if binary.BigEndian.Uint32(buf[len(buf)-4:]) != checksum {
+		return nil, fmt.Errorf("inspect data checksum mismatch")
+	}
+
+	var item ItemPreviewData
+	if err := decodeItemPreviewData(proto, &item); err != nil {
+		return nil, fmt.Errorf("failed to decode item preview data: %w", err)
+	}
+	return &item, nil
+}
+
+// decodeItemPreviewData decodes a CEconItemPreviewDataBlock message
+func decodeItemPreviewData(data []byte, item *ItemPreviewData) error {
+	return decodeProtoFields(data, func(field int, value protoValue) error {
+		switch field {
+		case 1:
+			item.AccountID = uint32(value.number)
+		case 2:
+			item.ItemID = value.number
+		case 3:
+			item.DefIndex = uint32(value.number)
+		case 4:
+			item.PaintIndex = uint32(value.number)
+		case 5:
+			item.Rarity = uint32(value.number)
+		case 6:
+			item.Quality = uint32(value.number)
+		case 7:
+			item.PaintWear = math.Float32frombits(uint32(value.number))
+		case 8:
+			item.PaintSeed = uint32(value.number)
+		case 9:
+			item.KillEaterScoreType = uint32(value.number)
+		case 10:
+			item.KillEaterValue = uint32(value.number)
+		case 11:
+			item.CustomName = string(value.bytes)
+		case 12, 20:
+			var sticker ItemSticker
+			if err := decodeItemSticker(value.bytes, &sticker); err != nil {
+				return err
+			}
+			if field == 12 {
+				item.Stickers = append(item.Stickers, sticker)
+			} else {
+				item.Keychains = append(item.Keychains, sticker)
+			}
+		case 13:
+			item.Inventory = uint32(value.number)
+		case 14:
+			item.Origin = uint32(value.number)
+		case 15:
+			item.QuestID = uint32(value.number)
+		case 16:
+			item.DropReason = uint32(value.number)
+		case 17:
+			item.MusicIndex = uint32(value.number)
+		case 18:
+			item.EntIndex = int32(value.number)
+		case 19:
+			item.PetIndex = uint32(value.number)
+		}
+		return nil
+	})
+}
+
+// decodeItemSticker decodes a CEconItemPreviewDataBlock.Sticker message
+func decodeItemSticker(data []byte, sticker *ItemSticker) error {
+	return decodeProtoFields(data, func(field int, value protoValue) error {
+		float := math.Float32frombits(uint32(value.number))
+		switch field {
+		case 1:
+			sticker.Slot = uint32(value.number)
+		case 2:
+			sticker.StickerID = uint32(value.number)
+		case 3:
+			sticker.Wear = float
+		case 4:
+			sticker.Scale = float
+		case 5:
+			sticker.Rotation = float
+		case 6:
+			sticker.TintID = uint32(value.number)
+		case 7:
+			sticker.OffsetX = float
+		case 8:
+			sticker.OffsetY = float
+		case 9:
+			sticker.OffsetZ = float
+		case 10:
+			sticker.Pattern = uint32(value.number)
+		}
+		return nil
+	})
+}
+
+// protoValue represents a decoded protobuf field value; varints and fixed-width values are
+// stored in number, length-delimited values in bytes
+type protoValue struct {
+	number uint64
+	bytes  []byte
+}
+
+// decodeProtoFields walks the fields of a protobuf message and calls fn for each of them
+func decodeProtoFields(data []byte, fn func(field int, value protoValue) error) error {
+	for len(data) > 0 {
+		tag, n := binary.Uvarint(data)
+		if n <= 0 {
+			return fmt.Errorf("invalid field tag")
+		}
+		data = data[n:]
+
+		var value protoValue
+		switch tag & 7 {
+		case 0:
+			value.number, n = binary.Uvarint(data)
+			if n <= 0 {
+				return fmt.Errorf("invalid varint in field %d", tag>>3)
+			}
+			data = data[n:]
+		case 1:
+			if len(data) < 8 {
+				return fmt.Errorf("truncated fixed64 in field %d", tag>>3)
+			}
+			value.number = binary.LittleEndian.Uint64(data)
+			data = data[8:]
+		case 2:
+			length, n := binary.Uvarint(data)
+			if n <= 0 || uint64(len(data)-n) < length {
+				return fmt.Errorf("truncated bytes in field %d", tag>>3)
+			}
+			value.bytes = data[n : n+int(length)]
+			data = data[n+int(length):]
+		case 5:
+			if len(data) < 4 {
+				return fmt.Errorf("truncated fixed32 in field %d", tag>>3)
+			}
+			value.number = uint64(binary.LittleEndian.Uint32(data))
+			data = data[4:]
+		default:
+			return fmt.Errorf("unsupported wire type %d in field %d", tag&7, tag>>3)
+		}
+
+		if err := fn(int(tag>>3), value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
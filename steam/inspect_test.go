@@ -0,0 +1,88 @@
+package steam
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"hash/crc32"
+	"math"
+	"testing"
+)
+
+// maskInspectData builds a masked inspect payload from a protobuf message, the way the game does
+func maskInspectData(proto []byte, key byte) string {
+	payload := append([]byte{0}, proto...)
+	crc := crc32.ChecksumIEEE(payload)
+	checksum := make([]byte, 4)
+	binary.BigEndian.PutUint32(checksum, (crc&0xffff)^(uint32(len(proto))*crc))
+	buf := append(payload, checksum...)
+	for i := range buf {
+		buf[i] ^= key
+	}
+	return hex.EncodeToString(buf)
+}
+
+func TestDecodeInspectData(t *testing.T) {
+	item, err := DecodeInspectData("00183C20B803280538E9A3C5DD0340E102C246A0D1")
+	if err != nil {
+		t.Fatalf("DecodeInspectData returned error: %v", err)
+	}
+	if item.DefIndex != 60 || item.PaintIndex != 440 || item.Rarity != 5 || item.PaintSeed != 353 {
+		t.Errorf("unexpected item: %+v", item)
+	}
+	if math.Abs(float64(item.PaintWear)-0.005411376) > 1e-9 {
+		t.Errorf("PaintWear = %v, want 0.005411376", item.PaintWear)
+	}
+}
+
+func TestDecodeInspectDataStickers(t *testing.T) {
+	wear := math.Float32bits(0.25)
+	sticker := []byte{
+		0x08, 0x02, // slot 2
+		0x10, 0xb9, 0x60, // sticker_id 12345
+		0x1d, byte(wear), byte(wear >> 8), byte(wear >> 16), byte(wear >> 24), // wear
+	}
+	proto := []byte{0x18, 0x07, 0x5a, 0x03, 'A', 'W', 'P', 0x62, byte(len(sticker))} // defindex 7, customname, sticker
+	proto = append(proto, sticker...)
+
+	item, err := DecodeInspectData(maskInspectData(proto, 0xe3))
+	if err != nil {
+		t.Fatalf("DecodeInspectData returned error: %v", err)
+	}
+	if item.DefIndex != 7 || item.CustomName != "AWP" {
+		t.Errorf("unexpected item: %+v", item)
+	}
+	if len(item.Stickers) != 1 {
+		t.Fatalf("got %d stickers, want 1", len(item.Stickers))
+	}
+	if s := item.Stickers[0]; s.Slot != 2 || s.StickerID != 12345 || s.Wear != 0.25 {
+		t.Errorf("unexpected sticker: %+v", s)
+	}
+}
+
+func TestDecodeInspectDataChecksum(t *testing.T) {
+	data := maskInspectData([]byte{0x18, 0x07}, 0x42)
+	corrupted := data[:len(data)-2] + "00"
+	if corrupted == data {
+		corrupted = data[:len(data)-2] + "11"
+	}
+	if _, err := DecodeInspectData(corrupted); err == nil {
+		t.Error("DecodeInspectData accepted a corrupted checksum")
+	}
+}
+
+func TestParseInspectLink(t *testing.T) {
+	link, err := ParseInspectLink("steam://rungame/730/76561202255233023/+csgo_econ_action_preview%20S76561198000000000A123D456")
+	if err != nil {
+		t.Fatalf("ParseInspectLink returned error: %v", err)
+	}
+	if link.Masked || link.OwnerSteamID != "76561198000000000" || link.AssetID != "123" || link.D != "456" {
+		t.Errorf("unexpected link: %+v", link)
+	}
+	if _, err := link.Resolve(nil); err != ErrInspectResolverRequired {
+		t.Errorf("Resolve(nil) error = %v, want ErrInspectResolverRequired", err)
+	}
+
+	if _, err := ParseInspectLink("steam://rungame/730/76561202255233023/+csgo_econ_action_preview%20S%owner_steamid%A%assetid%D1"); err == nil {
+		t.Error("ParseInspectLink accepted unfilled placeholders")
+	}
+}
@@ -0,0 +1,96 @@
+package steam
+
+import "fmt"
+
+// inventoryPageSize is the number of assets requested per inventory page; Steam rejects
+// larger counts for inventories other than the caller's own
+const inventoryPageSize = 2000
+
+// InventoryIterator walks a player's inventory one page at a time
+type InventoryIterator struct {
+	steamID      string
+	appID        int
+	contextID    int
+	startAssetID string
+	done         bool
+	page         *PlayerInventoryResponse
+	err          error
+}
+
+// NewInventoryIterator creates an iterator over the pages of a player's inventory
+// steamID: SteamID64 of the player
+// appID: Application ID (e.g., 730 for CS:GO)
+// contextID: Context ID (e.g., 2 for CS:GO)
+func NewInventoryIterator(steamID string, appID, contextID int) *InventoryIterator {
+	return &InventoryIterator{
+		steamID:   steamID,
+		appID:     appID,
+		contextID: contextID,
+	}
+}
+
+// Next fetches the next page, returning false when there are no more pages or an error occurred
+func (it *InventoryIterator) Next() bool {
+	if it.done {
+		return false
+	}
+
+	page, err := getPlayerInventoryPage(it.steamID, it.appID, it.contextID, it.startAssetID)
+	if err != nil {
+		it.err = err
+		it.done = true
+		return false
+	}
+
+	page.Items = page.BuildItems()
+	it.page = page
+	if page.MoreItems == 0 || page.LastAssetID == "" {
+		it.done = true
+	} else {
+		it.startAssetID = page.LastAssetID
+	}
+	return true
+}
+
+// Page returns the page fetched by the last call to Next
+func (it *InventoryIterator) Page() *PlayerInventoryResponse {
+	return it.page
+}
+
+// Err returns the error that stopped the iteration, if any
+func (it *InventoryIterator) Err() error {
+	return it.err
+}
+
+// CollectInventory drains an inventory iterator and merges every page into a single response.
+// Descriptions shared between pages are only included once, and Items is built from the result.
+// it: Iterator to drain
+func CollectInventory(it *InventoryIterator) (*PlayerInventoryResponse, error) {
+	result := &PlayerInventoryResponse{}
+	seen := make(map[string]bool)
+
+	for it.Next() {
+		page := it.Page()
+		result.Assets = append(result.Assets, page.Assets...)
+		for _, description := range page.Descriptions {
+			key := description.ClassID + "_" + description.InstanceID
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+			result.Descriptions = append(result.Descriptions, description)
+		}
+		result.TotalInventoryCount = page.TotalInventoryCount
+		result.Success = page.Success
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	if result.TotalInventoryCount > len(result.Assets) {
+		return nil, fmt.Errorf("inventory incomplete: got %d of %d assets", len(result.Assets), result.TotalInventoryCount)
+	}
+	result.Items = result.BuildItems()
+
+	return result, nil
+}
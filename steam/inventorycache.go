@@ -0,0 +1,238 @@
+package steam
+
+import (
+	"container/list"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// inventoryCache is the cache used by GetPlayerInventories; caching is disabled while it holds nil
+var inventoryCache atomic.Pointer[InventoryCache]
+
+// SetInventoryCache makes GetPlayerInventories serve inventories from cache, or disables caching if cache is nil
+// cache: Cache to use
+func SetInventoryCache(cache *InventoryCache) {
+	inventoryCache.Store(cache)
+}
+
+// InvalidateInventory drops every cached inventory context of a player from the inventory cache, if one is set.
+// It is called after accepting or sending trade offers and after market actions that move assets.
+// Call it as well when a sent trade offer is found to have been accepted.
+// steamID: SteamID64 of the player
+func InvalidateInventory(steamID string) {
+	if cache := inventoryCache.Load(); cache != nil {
+		cache.InvalidateSteamID(steamID)
+	}
+}
+
+// InventoryCache is an LRU cache of inventory responses with a time-to-live, keyed by steamid/appid/contextid.
+// It is safe for concurrent use. Cached responses are shared and must not be modified.
+type InventoryCache struct {
+	mu       sync.Mutex
+	capacity int
+	ttl      time.Duration
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+// inventoryCacheEntry represents a cached inventory
+type inventoryCacheEntry struct {
+	Key       string                   `json:"key"`
+	Inventory *PlayerInventoryResponse `json:"inventory"`
+	FetchedAt time.Time                `json:"fetched_at"`
+}
+
+// inventoryCacheKey identifies a cached inventory context; InvalidateSteamID relies on the steamID prefix
+func inventoryCacheKey(steamID string, appID, contextID int) string {
+	return fmt.Sprintf("%s/%d/%d", steamID, appID, contextID)
+}
+
+// NewInventoryCache creates a new InventoryCache
+// capacity: Maximum number of inventory contexts kept; the least recently used one is evicted first.
+// A capacity of 0 or less leaves the cache unbounded; expired entries are then only dropped when
+// looked up, invalidated or removed by Prune.
+// ttl: How long an inventory stays valid after it was fetched
+func NewInventoryCache(capacity int, ttl time.Duration) *InventoryCache {
+	return &InventoryCache{
+		capacity: capacity,
+		ttl:      ttl,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+// Get returns a cached inventory if it is present and has not expired
+// steamID: SteamID64 of the player
+// appID: Application ID
+// contextID: Context ID
+func (c *InventoryCache) Get(steamID string, appID, contextID int) (*PlayerInventoryResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[inventoryCacheKey(steamID, appID, contextID)]
+	if !ok {
+		return nil, false
+	}
+	entry := element.Value.(*inventoryCacheEntry)
+	if time.Since(entry.FetchedAt) > c.ttl {
+		c.remove(element)
+		return nil, false
+	}
+
+	c.order.MoveToFront(element)
+	return entry.Inventory, true
+}
+
+// Set stores an inventory, evicting the least recently used entry if the cache is full
+// steamID: SteamID64 of the player
+// appID: Application ID
+// contextID: Context ID
+// inventory: Inventory to cache
+func (c *InventoryCache) Set(steamID string, appID, contextID int, inventory *PlayerInventoryResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.set(&inventoryCacheEntry{
+		Key:       inventoryCacheKey(steamID, appID, contextID),
+		Inventory: inventory,
+		FetchedAt: time.Now(),
+	})
+}
+
+// set stores an entry; the caller must hold c.mu
+func (c *InventoryCache) set(entry *inventoryCacheEntry) {
+	if element, ok := c.entries[entry.Key]; ok {
+		element.Value = entry
+		c.order.MoveToFront(element)
+		return
+	}
+
+	c.entries[entry.Key] = c.order.PushFront(entry)
+	for c.capacity > 0 && c.order.Len() > c.capacity {
+		c.remove(c.order.Back())
+	}
+}
+
+// remove drops an entry; the caller must hold c.mu
+func (c *InventoryCache) remove(element *list.Element) {
+	c.order.Remove(element)
+	delete(c.entries, element.Value.(*inventoryCacheEntry).Key)
+}
+
+// Invalidate drops a single cached inventory context
+// steamID: SteamID64 of the player
+// appID: Application ID
+// contextID: Context ID
+func (c *InventoryCache) Invalidate(steamID string, appID, contextID int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[inventoryCacheKey(steamID, appID, contextID)]; ok {
+		c.remove(element)
+	}
+}
+
+// InvalidateSteamID drops every cached inventory context of a player
+// steamID: SteamID64 of the player
+func (c *InventoryCache) InvalidateSteamID(steamID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	prefix := steamID + "/"
+	for key, element := range c.entries {
+		if strings.HasPrefix(key, prefix) {
+			c.remove(element)
+		}
+	}
+}
+
+// Prune drops every expired inventory
+func (c *InventoryCache) Prune() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for element := c.order.Front(); element != nil; {
+		next := element.Next()
+		if time.Since(element.Value.(*inventoryCacheEntry).FetchedAt) > c.ttl {
+			c.remove(element)
+		}
+		element = next
+	}
+}
+
+// Purge drops every cached inventory
+func (c *InventoryCache) Purge() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[string]*list.Element)
+	c.order.Init()
+}
+
+// Len returns the number of cached inventory contexts, including expired ones not yet evicted
+func (c *InventoryCache) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.order.Len()
+}
+
+// SaveFile writes the unexpired cached inventories to a JSON file, most recently used first
+// path: Path of the file to write
+func (c *InventoryCache) SaveFile(path string) error {
+	c.mu.Lock()
+	entries := make([]*inventoryCacheEntry, 0, c.order.Len())
+	for element := c.order.Front(); element != nil; element = element.Next() {
+		entry := element.Value.(*inventoryCacheEntry)
+		if time.Since(entry.FetchedAt) <= c.ttl {
+			entries = append(entries, entry)
+		}
+	}
+	c.mu.Unlock()
+
+	data, err := json.Marshal(entries)
+	if err != nil {
+		return fmt.Errorf("failed to encode inventory cache: %w", err)
+	}
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		return fmt.Errorf("failed to write inventory cache: %w", err)
+	}
+	return nil
+}
+
+// LoadFile adds the inventories saved by SaveFile to the cache, skipping expired ones.
+// A missing file is not an error.
+// path: Path of the file to read
+func (c *InventoryCache) LoadFile(path string) error {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read inventory cache: %w", err)
+	}
+
+	var entries []*inventoryCacheEntry
+	if err := json.Unmarshal(data, &entries); err != nil {
+		return fmt.Errorf("failed to decode inventory cache: %w", err)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	// Insert oldest first so the saved recency order is kept
+	for i := len(entries) - 1; i >= 0; i-- {
+		entry := entries[i]
+		if entry.Inventory == nil || time.Since(entry.FetchedAt) > c.ttl {
+			continue
+		}
+		entry.Inventory.Items = entry.Inventory.BuildItems()
+		c.set(entry)
+	}
+	return nil
+}
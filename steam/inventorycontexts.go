@@ -0,0 +1,167 @@
+package steam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// appContextDataRegexp extracts the g_rgAppContextData object from the profile inventory page
+var appContextDataRegexp = regexp.MustCompile(`var g_rgAppContextData\s*=\s*(\{.*?\}|\[\]);\s*\n`)
+
+// inventoryLoadWorkers is the number of inventory contexts loaded at the same time
+const inventoryLoadWorkers = 4
+
+// InventoryContext represents one context of an app inventory, e.g. 753/6 for Steam community items
+type InventoryContext struct {
+	ID         int    `json:"id"`
+	Name       string `json:"name"`
+	AssetCount int    `json:"asset_count"`
+}
+
+// InventoryApp represents an app the player has an inventory for, along with its contexts
+type InventoryApp struct {
+	AppID            int                `json:"appid"`
+	Name             string             `json:"name"`
+	Icon             string             `json:"icon"`
+	Link             string             `json:"link"`
+	AssetCount       int                `json:"asset_count"`
+	TradePermissions string             `json:"trade_permissions"`
+	LoadFailed       bool               `json:"load_failed"`
+	OwnerOnly        bool               `json:"owner_only"`
+	Contexts         []InventoryContext `json:"contexts"`
+}
+
+// appContextData represents an entry of g_rgAppContextData
+type appContextData struct {
+	AppID            int             `json:"appid"`
+	Name             string          `json:"name"`
+	Icon             string          `json:"icon"`
+	Link             string          `json:"link"`
+	AssetCount       int             `json:"asset_count"`
+	TradePermissions string          `json:"trade_permissions"`
+	LoadFailed       int             `json:"load_failed"`
+	OwnerOnly        bool            `json:"owner_only"`
+	Contexts         json.RawMessage `json:"rgContexts"`
+}
+
+// contextData represents an entry of rgContexts
+type contextData struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	AssetCount int    `json:"asset_count"`
+}
+
+// GetInventoryContexts discovers the apps and contexts a player has inventories for,
+// from the g_rgAppContextData object on their profile inventory page.
+// Apps are sorted by app ID and contexts by context ID.
+// steamID: SteamID64 of the player
+func GetInventoryContexts(steamID string) ([]InventoryApp, error) {
+	rateLimiter.Wait()
+
+	body, err := getBody(http.DefaultClient, fmt.Sprintf("https://steamcommunity.com/profiles/%s/inventory/", steamID))
+	if err != nil {
+		return nil, err
+	}
+
+	if bytes.Contains(body, []byte(`class="profile_private_info"`)) {
+		return nil, &PrivateProfileError{SteamID: steamID, Visibility: VisibilityPrivate}
+	}
+
+	match := appContextDataRegexp.FindSubmatch(body)
+	if match == nil {
+		return nil, fmt.Errorf("inventory context data not found on profile page")
+	}
+
+	// Steam renders an empty array instead of an object when there are no inventories
+	var data map[string]appContextData
+	if err := decodeObject(match[1], &data); err != nil {
+		return nil, fmt.Errorf("failed to decode inventory context data: %w", err)
+	}
+
+	apps := make([]InventoryApp, 0, len(data))
+	for _, entry := range data {
+		app := InventoryApp{
+			AppID:            entry.AppID,
+			Name:             entry.Name,
+			Icon:             entry.Icon,
+			Link:             entry.Link,
+			AssetCount:       entry.AssetCount,
+			TradePermissions: entry.TradePermissions,
+			LoadFailed:       entry.LoadFailed != 0,
+			OwnerOnly:        entry.OwnerOnly,
+		}
+
+		var contexts map[string]contextData
+		if err := decodeObject(entry.Contexts, &contexts); err != nil {
+			return nil, fmt.Errorf("failed to decode contexts of app %d: %w", entry.AppID, err)
+		}
+		for _, c := range contexts {
+			id, err := strconv.Atoi(c.ID)
+			if err != nil {
+				return nil, fmt.Errorf("invalid context ID %q for app %d", c.ID, entry.AppID)
+			}
+			app.Contexts = append(app.Contexts, InventoryContext{ID: id, Name: c.Name, AssetCount: c.AssetCount})
+		}
+		sort.Slice(app.Contexts, func(i, j int) bool {
+			return app.Contexts[i].ID < app.Contexts[j].ID
+		})
+
+		apps = append(apps, app)
+	}
+	sort.Slice(apps, func(i, j int) bool {
+		return apps[i].AppID < apps[j].AppID
+	})
+
+	return apps, nil
+}
+
+// ContextInventory represents the loaded inventory of a single app context
+type ContextInventory struct {
+	AppID     int
+	ContextID int
+	Inventory *PlayerInventoryResponse
+	Err       error
+}
+
+// GetAllInventories discovers every inventory app and context of a player and loads them concurrently.
+// Contexts without assets are skipped. Requests still go through the shared rate limiter, and a
+// context that fails to load is reported through its Err without affecting the others.
+// steamID: SteamID64 of the player
+func GetAllInventories(steamID string) ([]ContextInventory, error) {
+	apps, err := GetInventoryContexts(steamID)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []ContextInventory
+	for _, app := range apps {
+		for _, context := range app.Contexts {
+			if context.AssetCount == 0 {
+				continue
+			}
+			results = append(results, ContextInventory{AppID: app.AppID, ContextID: context.ID})
+		}
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, inventoryLoadWorkers)
+	for i := range results {
+		wg.Add(1)
+		go func(result *ContextInventory) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+
+			result.Inventory, result.Err = GetPlayerInventories("", steamID, result.AppID, result.ContextID)
+		}(&results[i])
+	}
+	wg.Wait()
+
+	return results, nil
+}
@@ -0,0 +1,186 @@
+package steam
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MarketPricer is a Pricer backed by the market priceoverview API, caching prices for a while
+// to stay within the market rate limits. It is safe for concurrent use.
+type MarketPricer struct {
+	Bot      *Bot
+	Currency Currency
+	TTL      time.Duration
+
+	mu    sync.Mutex
+	cache map[string]cachedPrice
+}
+
+// cachedPrice represents a price fetched by MarketPricer
+type cachedPrice struct {
+	price     int
+	fetchedAt time.Time
+}
+
+// NewMarketPricer creates a new MarketPricer
+// bot: Bot used to look up price overviews
+// currency: Currency prices are returned in
+// ttl: How long a fetched price is reused
+func NewMarketPricer(bot *Bot, currency Currency, ttl time.Duration) *MarketPricer {
+	return &MarketPricer{
+		Bot:      bot,
+		Currency: currency,
+		TTL:      ttl,
+		cache:    make(map[string]cachedPrice),
+	}
+}
+
+// Price returns the lowest listing price of an item, or its median sale price if nothing is listed
+// appID: Application ID (e.g., 730 for CS:GO)
+// marketHashName: Market hash name of the item
+func (p *MarketPricer) Price(appID int, marketHashName string) (int, error) {
+	key := strconv.Itoa(appID) + "/" + marketHashName
+
+	p.mu.Lock()
+	cached, ok := p.cache[key]
+	p.mu.Unlock()
+	if ok && time.Since(cached.fetchedAt) <= p.TTL {
+		return cached.price, nil
+	}
+
+	overview, err := p.Bot.GetPriceOverview(appID, marketHashName, p.Currency)
+	if err != nil {
+		return 0, err
+	}
+	price, err := overview.LowestPriceAmount()
+	if err != nil {
+		price, err = overview.MedianPriceAmount()
+		if err != nil {
+			return 0, fmt.Errorf("no price available for %s", marketHashName)
+		}
+	}
+
+	p.mu.Lock()
+	if p.cache == nil {
+		p.cache = make(map[string]cachedPrice)
+	}
+	p.cache[key] = cachedPrice{price: price, fetchedAt: time.Now()}
+	p.mu.Unlock()
+	return price, nil
+}
+
+// InventoryValuation represents the estimated value of all items sharing a market hash name and tradability.
+// Prices are in hundredths of the pricer's currency.
+type InventoryValuation struct {
+	AppID          int    `json:"appid"`
+	MarketHashName string `json:"market_hash_name"`
+	Name           string `json:"name"`
+	Quantity       int    `json:"quantity"`
+	Tradable       bool   `json:"tradable"`
+	Marketable     bool   `json:"marketable"`
+	UnitPrice      int    `json:"unit_price"`
+	TotalValue     int    `json:"total_value"`
+	PriceError     string `json:"price_error,omitempty"`
+}
+
+// ValueInventory groups items by app, market hash name and tradability and estimates each group's value.
+// Only marketable items are priced; a failed lookup is recorded in PriceError instead of aborting.
+// The result is sorted by total value, highest first.
+// items: Items to value
+// pricer: Pricer used to estimate unit prices
+func ValueInventory(items []InventoryItem, pricer Pricer) []InventoryValuation {
+	type groupKey struct {
+		appID          int
+		marketHashName string
+		tradable       bool
+	}
+	groups := make(map[groupKey]*InventoryValuation)
+	var order []groupKey
+
+	for _, item := range items {
+		key := groupKey{item.AppID, item.MarketHashName, item.Tradable}
+		group, ok := groups[key]
+		if !ok {
+			group = &InventoryValuation{
+				AppID:          item.AppID,
+				MarketHashName: item.MarketHashName,
+				Name:           item.Name,
+				Tradable:       item.Tradable,
+				Marketable:     item.Marketable,
+			}
+			groups[key] = group
+			order = append(order, key)
+		}
+		group.Quantity += item.Amount
+	}
+
+	valuations := make([]InventoryValuation, 0, len(order))
+	for _, key := range order {
+		group := groups[key]
+		if group.Marketable && group.MarketHashName != "" {
+			price, err := pricer.Price(group.AppID, group.MarketHashName)
+			if err != nil {
+				group.PriceError = err.Error()
+			} else {
+				group.UnitPrice = price
+				group.TotalValue = price * group.Quantity
+			}
+		}
+		valuations = append(valuations, *group)
+	}
+
+	sort.SliceStable(valuations, func(i, j int) bool {
+		return valuations[i].TotalValue > valuations[j].TotalValue
+	})
+	return valuations
+}
+
+// ExportInventoryCSV writes an inventory valuation as CSV with a header row
+// w: Writer the CSV is written to
+// valuations: Valuations to export, e.g. from ValueInventory
+func ExportInventoryCSV(w io.Writer, valuations []InventoryValuation) error {
+	writer := csv.NewWriter(w)
+	header := []string{"appid", "market_hash_name", "name", "quantity", "tradable", "marketable",
+		"unit_price", "total_value", "price_error"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, v := range valuations {
+		record := []string{
+			strconv.Itoa(v.AppID),
+			v.MarketHashName,
+			v.Name,
+			strconv.Itoa(v.Quantity),
+			strconv.FormatBool(v.Tradable),
+			strconv.FormatBool(v.Marketable),
+			strconv.Itoa(v.UnitPrice),
+			strconv.Itoa(v.TotalValue),
+			v.PriceError,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ExportInventoryJSON writes an inventory valuation as a JSON array
+// w: Writer the JSON is written to
+// valuations: Valuations to export, e.g. from ValueInventory
+func ExportInventoryJSON(w io.Writer, valuations []InventoryValuation) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(valuations); err != nil {
+		return fmt.Errorf("failed to write inventory valuation: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package steam
+
+import "strconv"
+
+// economyImageURL is the base URL of item icons
+const economyImageURL = "https://community.akamai.steamstatic.com/economy/image/"
+
+// InventoryAsset represents an asset in a player's inventory
+type InventoryAsset struct {
+	AppID      int    `json:"appid"`
+	ContextID  string `json:"contextid"`
+	AssetID    string `json:"assetid"`
+	ClassID    string `json:"classid"`
+	InstanceID string `json:"instanceid"`
+	Amount     string `json:"amount"`
+}
+
+// ItemTag represents a tag attached to an item description, such as its rarity or exterior
+type ItemTag struct {
+	Category              string `json:"category"`
+	InternalName          string `json:"internal_name"`
+	LocalizedCategoryName string `json:"localized_category_name"`
+	LocalizedTagName      string `json:"localized_tag_name"`
+	Color                 string `json:"color"`
+}
+
+// ItemDescriptionLine represents a line of text in an item description
+type ItemDescriptionLine struct {
+	Type  string `json:"type"`
+	Value string `json:"value"`
+	Color string `json:"color"`
+	Name  string `json:"name"`
+}
+
+// InventoryDescription represents the description shared by all assets of the same class and instance
+type InventoryDescription struct {
+	AppID                       int                   `json:"appid"`
+	ClassID                     string                `json:"classid"`
+	InstanceID                  string                `json:"instanceid"`
+	Currency                    int                   `json:"currency"`
+	BackgroundColor             string                `json:"background_color"`
+	IconURL                     string                `json:"icon_url"`
+	IconURLLarge                string                `json:"icon_url_large"`
+	Descriptions                []ItemDescriptionLine `json:"descriptions"`
+	Tradable                    int                   `json:"tradable"`
+	Actions                     []MarketAction        `json:"actions"`
+	OwnerDescriptions           []ItemDescriptionLine `json:"owner_descriptions"`
+	OwnerActions                []MarketAction        `json:"owner_actions"`
+	FraudWarnings               []string              `json:"fraudwarnings"`
+	Name                        string                `json:"name"`
+	NameColor                   string                `json:"name_color"`
+	Type                        string                `json:"type"`
+	MarketName                  string                `json:"market_name"`
+	MarketHashName              string                `json:"market_hash_name"`
+	MarketActions               []MarketAction        `json:"market_actions"`
+	Commodity                   int                   `json:"commodity"`
+	MarketTradableRestriction   int                   `json:"market_tradable_restriction"`
+	MarketMarketableRestriction int                   `json:"market_marketable_restriction"`
+	Marketable                  int                   `json:"marketable"`
+	Tags                        []ItemTag             `json:"tags"`
+}
+
+// InventoryItem represents an inventory asset merged with its description
+type InventoryItem struct {
+	AppID      int    `json:"appid"`
+	ContextID  string `json:"contextid"`
+	AssetID    string `json:"assetid"`
+	ClassID    string `json:"classid"`
+	InstanceID string `json:"instanceid"`
+	Amount     int    `json:"amount"`
+
+	Name            string `json:"name"`
+	MarketName      string `json:"market_name"`
+	MarketHashName  string `json:"market_hash_name"`
+	Type            string `json:"type"`
+	NameColor       string `json:"name_color"`
+	BackgroundColor string `json:"background_color"`
+	IconURL         string `json:"icon_url"`
+	IconURLLarge    string `json:"icon_url_large"`
+
+	Tradable                    bool `json:"tradable"`
+	Marketable                  bool `json:"marketable"`
+	Commodity                   bool `json:"commodity"`
+	MarketTradableRestriction   int  `json:"market_tradable_restriction"`
+	MarketMarketableRestriction int  `json:"market_marketable_restriction"`
+
+	Tags              []ItemTag             `json:"tags"`
+	Descriptions      []ItemDescriptionLine `json:"descriptions"`
+	OwnerDescriptions []ItemDescriptionLine `json:"owner_descriptions"`
+	FraudWarnings     []string              `json:"fraudwarnings"`
+	Actions           []MarketAction        `json:"actions"`
+	OwnerActions      []MarketAction        `json:"owner_actions"`
+	MarketActions     []MarketAction        `json:"market_actions"`
+}
+
+// BuildItems joins every asset with its description on classid and instanceid.
+// Assets without a description are returned with only their asset fields set.
+func (r *PlayerInventoryResponse) BuildItems() []InventoryItem {
+	descriptions := make(map[string]*InventoryDescription, len(r.Descriptions))
+	for i := range r.Descriptions {
+		d := &r.Descriptions[i]
+		descriptions[d.ClassID+"_"+d.InstanceID] = d
+	}
+
+	items := make([]InventoryItem, 0, len(r.Assets))
+	for _, asset := range r.Assets {
+		amount, _ := strconv.Atoi(asset.Amount)
+		item := InventoryItem{
+			AppID:      asset.AppID,
+			ContextID:  asset.ContextID,
+			AssetID:    asset.AssetID,
+			ClassID:    asset.ClassID,
+			InstanceID: asset.InstanceID,
+			Amount:     amount,
+		}
+
+		if d, ok := descriptions[asset.ClassID+"_"+asset.InstanceID]; ok {
+			item.Name = d.Name
+			item.MarketName = d.MarketName
+			item.MarketHashName = d.MarketHashName
+			item.Type = d.Type
+			item.NameColor = d.NameColor
+			item.BackgroundColor = d.BackgroundColor
+			if d.IconURL != "" {
+				item.IconURL = economyImageURL + d.IconURL
+			}
+			if d.IconURLLarge != "" {
+				item.IconURLLarge = economyImageURL + d.IconURLLarge
+			}
+			item.Tradable = d.Tradable == 1
+			item.Marketable = d.Marketable == 1
+			item.Commodity = d.Commodity == 1
+			item.MarketTradableRestriction = d.MarketTradableRestriction
+			item.MarketMarketableRestriction = d.MarketMarketableRestriction
+			item.Tags = d.Tags
+			item.Descriptions = d.Descriptions
+			item.OwnerDescriptions = d.OwnerDescriptions
+			item.FraudWarnings = d.FraudWarnings
+			item.Actions = d.Actions
+			item.OwnerActions = d.OwnerActions
+			item.MarketActions = d.MarketActions
+		}
+
+		items = append(items, item)
+	}
+
+	return items
+}
@@ -0,0 +1,137 @@
+package steam
+
+import "regexp"
+
+// ItemFilter reports whether an inventory item should be selected
+type ItemFilter func(item *InventoryItem) bool
+
+// Tag returns the item's tag in a category, e.g. "Rarity", "Quality", "Type" or "Exterior"
+// category: Tag category
+func (item *InventoryItem) Tag(category string) (ItemTag, bool) {
+	for _, tag := range item.Tags {
+		if tag.Category == category {
+			return tag, true
+		}
+	}
+	return ItemTag{}, false
+}
+
+// FilterItems returns the items matching every filter
+// items: Items to filter
+// filters: Filters the items must all match
+func FilterItems(items []InventoryItem, filters ...ItemFilter) []InventoryItem {
+	var result []InventoryItem
+	for i := range items {
+		if matchesAll(&items[i], filters) {
+			result = append(result, items[i])
+		}
+	}
+	return result
+}
+
+// Filter returns the inventory's items matching every filter
+// filters: Filters the items must all match
+func (r *PlayerInventoryResponse) Filter(filters ...ItemFilter) []InventoryItem {
+	return FilterItems(r.Items, filters...)
+}
+
+// matchesAll reports whether an item matches every filter
+func matchesAll(item *InventoryItem, filters []ItemFilter) bool {
+	for _, filter := range filters {
+		if !filter(item) {
+			return false
+		}
+	}
+	return true
+}
+
+// HasTag selects items with a tag of the given category and internal name, e.g. ("Rarity", "Rarity_Ancient_Weapon")
+// category: Tag category
+// internalName: Internal name of the tag
+func HasTag(category, internalName string) ItemFilter {
+	return func(item *InventoryItem) bool {
+		tag, ok := item.Tag(category)
+		return ok && tag.InternalName == internalName
+	}
+}
+
+// HasTagName selects items with a tag of the given category and localized name, e.g. ("Exterior", "Factory New")
+// category: Tag category
+// name: Localized name of the tag
+func HasTagName(category, name string) ItemFilter {
+	return func(item *InventoryItem) bool {
+		tag, ok := item.Tag(category)
+		return ok && tag.LocalizedTagName == name
+	}
+}
+
+// IsTradable selects tradable items
+func IsTradable() ItemFilter {
+	return func(item *InventoryItem) bool {
+		return item.Tradable
+	}
+}
+
+// IsMarketable selects marketable items
+func IsMarketable() ItemFilter {
+	return func(item *InventoryItem) bool {
+		return item.Marketable
+	}
+}
+
+// NameMatches selects items whose name or market hash name matches a regular expression
+// re: Regular expression to match
+func NameMatches(re *regexp.Regexp) ItemFilter {
+	return func(item *InventoryItem) bool {
+		return re.MatchString(item.Name) || re.MatchString(item.MarketHashName)
+	}
+}
+
+// InApp selects items of an application
+// appID: Application ID (e.g., 730 for CS:GO)
+func InApp(appID int) ItemFilter {
+	return func(item *InventoryItem) bool {
+		return item.AppID == appID
+	}
+}
+
+// Not selects the items a filter rejects
+// filter: Filter to invert
+func Not(filter ItemFilter) ItemFilter {
+	return func(item *InventoryItem) bool {
+		return !filter(item)
+	}
+}
+
+// AnyOf selects items matching at least one of the filters
+// filters: Filters of which one must match
+func AnyOf(filters ...ItemFilter) ItemFilter {
+	return func(item *InventoryItem) bool {
+		for _, filter := range filters {
+			if filter(item) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// GroupByMarketHashName groups items by their market hash name
+// items: Items to group
+func GroupByMarketHashName(items []InventoryItem) map[string][]InventoryItem {
+	groups := make(map[string][]InventoryItem)
+	for _, item := range items {
+		groups[item.MarketHashName] = append(groups[item.MarketHashName], item)
+	}
+	return groups
+}
+
+// CountByMarketHashName sums the amounts of the items sharing a market hash name
+// items: Items to count
+func CountByMarketHashName(items []InventoryItem) map[string]int {
+	counts := make(map[string]int)
+	for _, item := range items {
+		counts[item.MarketHashName] += item.Amount
+	}
+	return counts
+}
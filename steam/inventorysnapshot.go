@@ -0,0 +1,300 @@
+package steam
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"sync"
+	"time"
+)
+
+// InventorySnapshot represents the contents of one inventory context at a point in time
+type InventorySnapshot struct {
+	SteamID   string                   `json:"steamid"`
+	AppID     int                      `json:"appid"`
+	ContextID int                      `json:"contextid"`
+	TakenAt   time.Time                `json:"taken_at"`
+	Items     map[string]InventoryItem `json:"items"`
+}
+
+// NewInventorySnapshot creates a snapshot from a list of inventory items, keyed by asset ID
+// steamID: SteamID64 of the inventory owner
+// appID: Application ID
+// contextID: Context ID
+// items: Items currently in the inventory
+func NewInventorySnapshot(steamID string, appID, contextID int, items []InventoryItem) *InventorySnapshot {
+	snapshot := &InventorySnapshot{
+		SteamID:   steamID,
+		AppID:     appID,
+		ContextID: contextID,
+		TakenAt:   time.Now().UTC(),
+		Items:     make(map[string]InventoryItem, len(items)),
+	}
+	for _, item := range items {
+		snapshot.Items[item.AssetID] = item
+	}
+	return snapshot
+}
+
+// TakeInventorySnapshot fetches a player's inventory and returns it as a snapshot
+// steamID: SteamID64 of the player
+// appID: Application ID (e.g., 730 for CS:GO)
+// contextID: Context ID (e.g., 2 for CS:GO)
+func TakeInventorySnapshot(steamID string, appID, contextID int) (*InventorySnapshot, error) {
+	inventory, err := CollectInventory(NewInventoryIterator(steamID, appID, contextID))
+	if err != nil {
+		return nil, err
+	}
+	return NewInventorySnapshot(steamID, appID, contextID, inventory.Items), nil
+}
+
+// InventoryChangeType represents the kind of change between two inventory snapshots
+type InventoryChangeType string
+
+const (
+	InventoryItemAdded         InventoryChangeType = "added"
+	InventoryItemRemoved       InventoryChangeType = "removed"
+	InventoryItemAmountChanged InventoryChangeType = "amount_changed"
+)
+
+// InventoryChange represents a single item that changed between two snapshots
+type InventoryChange struct {
+	Type      InventoryChangeType `json:"type"`
+	Item      InventoryItem       `json:"item"`
+	OldAmount int                 `json:"old_amount"`
+	NewAmount int                 `json:"new_amount"`
+}
+
+// InventoryDiff represents the changes between two inventory snapshots, sorted by asset ID
+type InventoryDiff struct {
+	From    time.Time         `json:"from"`
+	To      time.Time         `json:"to"`
+	Changes []InventoryChange `json:"changes"`
+}
+
+// Empty reports whether the snapshots were identical
+func (d *InventoryDiff) Empty() bool {
+	return len(d.Changes) == 0
+}
+
+// DiffInventorySnapshots computes the items added, removed or changed in amount between two snapshots
+// from: Older snapshot
+// to: Newer snapshot
+func DiffInventorySnapshots(from, to *InventorySnapshot) InventoryDiff {
+	diff := InventoryDiff{From: from.TakenAt, To: to.TakenAt}
+
+	for assetID, item := range to.Items {
+		old, ok := from.Items[assetID]
+		switch {
+		case !ok:
+			diff.Changes = append(diff.Changes, InventoryChange{Type: InventoryItemAdded, Item: item, NewAmount: item.Amount})
+		case old.Amount != item.Amount:
+			diff.Changes = append(diff.Changes, InventoryChange{Type: InventoryItemAmountChanged, Item: item, OldAmount: old.Amount, NewAmount: item.Amount})
+		}
+	}
+	for assetID, item := range from.Items {
+		if _, ok := to.Items[assetID]; !ok {
+			diff.Changes = append(diff.Changes, InventoryChange{Type: InventoryItemRemoved, Item: item, OldAmount: item.Amount})
+		}
+	}
+
+	sort.Slice(diff.Changes, func(i, j int) bool {
+		return assetIDLess(diff.Changes[i].Item.AssetID, diff.Changes[j].Item.AssetID)
+	})
+	return diff
+}
+
+// assetIDLess compares decimal asset IDs numerically without parsing them
+func assetIDLess(a, b string) bool {
+	if len(a) != len(b) {
+		return len(a) < len(b)
+	}
+	return a < b
+}
+
+// SnapshotStore stores inventory snapshots; implementations must be safe for concurrent use
+type SnapshotStore interface {
+	Save(snapshot *InventorySnapshot) error
+	// Latest returns the most recent snapshot for an inventory, or nil if there is none
+	Latest(steamID string, appID, contextID int) (*InventorySnapshot, error)
+}
+
+// MemorySnapshotStore is an in-memory SnapshotStore keeping the most recent snapshots of each inventory
+type MemorySnapshotStore struct {
+	mu        sync.Mutex
+	snapshots map[string][]*InventorySnapshot
+	limit     int
+}
+
+// NewMemorySnapshotStore creates a new MemorySnapshotStore
+// limit: Number of snapshots kept per inventory; 0 keeps all of them
+func NewMemorySnapshotStore(limit int) *MemorySnapshotStore {
+	return &MemorySnapshotStore{
+		snapshots: make(map[string][]*InventorySnapshot),
+		limit:     limit,
+	}
+}
+
+// snapshotKey identifies an inventory context
+func snapshotKey(steamID string, appID, contextID int) string {
+	return fmt.Sprintf("%s/%d/%d", steamID, appID, contextID)
+}
+
+// Save stores a snapshot, discarding the oldest one if the limit is exceeded
+func (s *MemorySnapshotStore) Save(snapshot *InventorySnapshot) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := snapshotKey(snapshot.SteamID, snapshot.AppID, snapshot.ContextID)
+	history := append(s.snapshots[key], snapshot)
+	if s.limit > 0 && len(history) > s.limit {
+		history = history[len(history)-s.limit:]
+	}
+	s.snapshots[key] = history
+	return nil
+}
+
+// Latest returns the most recent snapshot for an inventory, or nil if there is none
+func (s *MemorySnapshotStore) Latest(steamID string, appID, contextID int) (*InventorySnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history := s.snapshots[snapshotKey(steamID, appID, contextID)]
+	if len(history) == 0 {
+		return nil, nil
+	}
+	return history[len(history)-1], nil
+}
+
+// History returns every stored snapshot for an inventory, oldest first
+func (s *MemorySnapshotStore) History(steamID string, appID, contextID int) []*InventorySnapshot {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	history := s.snapshots[snapshotKey(steamID, appID, contextID)]
+	return append([]*InventorySnapshot(nil), history...)
+}
+
+// InventoryWatcher polls an inventory and emits the changes between consecutive snapshots
+type InventoryWatcher struct {
+	SteamID   string
+	AppID     int
+	ContextID int
+	Interval  time.Duration
+	Store     SnapshotStore
+
+	// OnError is called when a poll fails; errors are logged if it is nil
+	OnError func(err error)
+
+	stop     chan struct{}
+	initOnce sync.Once
+	stopOnce sync.Once
+}
+
+// NewInventoryWatcher creates a new InventoryWatcher backed by an in-memory snapshot store
+// steamID: SteamID64 of the player
+// appID: Application ID (e.g., 730 for CS:GO)
+// contextID: Context ID (e.g., 2 for CS:GO)
+// interval: Time between polls
+func NewInventoryWatcher(steamID string, appID, contextID int, interval time.Duration) *InventoryWatcher {
+	return &InventoryWatcher{
+		SteamID:   steamID,
+		AppID:     appID,
+		ContextID: contextID,
+		Interval:  interval,
+		Store:     NewMemorySnapshotStore(2),
+	}
+}
+
+// init creates the stop channel, so watchers built as struct literals work too
+func (w *InventoryWatcher) init() {
+	w.initOnce.Do(func() {
+		w.stop = make(chan struct{})
+	})
+}
+
+// Start begins polling in the background and returns the channel changes are sent on.
+// The first poll only records a baseline snapshot. The channel is closed after Stop.
+// A nil Store is replaced by an in-memory store.
+func (w *InventoryWatcher) Start() (<-chan InventoryChange, error) {
+	if w.Interval <= 0 {
+		return nil, fmt.Errorf("invalid inventory watcher interval: %s", w.Interval)
+	}
+	if w.Store == nil {
+		w.Store = NewMemorySnapshotStore(2)
+	}
+	w.init()
+
+	changes := make(chan InventoryChange)
+
+	go func() {
+		defer close(changes)
+
+		ticker := time.NewTicker(w.Interval)
+		defer ticker.Stop()
+
+		for {
+			if !w.poll(changes) {
+				return
+			}
+
+			select {
+			case <-w.stop:
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+
+	return changes, nil
+}
+
+// Stop stops polling
+func (w *InventoryWatcher) Stop() {
+	w.init()
+	w.stopOnce.Do(func() {
+		close(w.stop)
+	})
+}
+
+// poll takes a snapshot, compares it with the previous one and emits the changes.
+// It returns false if the watcher was stopped while emitting.
+// changes: Channel to send changes on
+func (w *InventoryWatcher) poll(changes chan<- InventoryChange) bool {
+	snapshot, err := TakeInventorySnapshot(w.SteamID, w.AppID, w.ContextID)
+	if err != nil {
+		w.reportError(fmt.Errorf("failed to take inventory snapshot: %w", err))
+		return true
+	}
+
+	previous, err := w.Store.Latest(w.SteamID, w.AppID, w.ContextID)
+	if err != nil {
+		w.reportError(fmt.Errorf("failed to load previous snapshot: %w", err))
+		return true
+	}
+	if err := w.Store.Save(snapshot); err != nil {
+		w.reportError(fmt.Errorf("failed to save inventory snapshot: %w", err))
+	}
+	if previous == nil {
+		return true
+	}
+
+	diff := DiffInventorySnapshots(previous, snapshot)
+	for _, change := range diff.Changes {
+		select {
+		case changes <- change:
+		case <-w.stop:
+			return false
+		}
+	}
+	return true
+}
+
+// reportError passes a polling error to OnError, or logs it
+func (w *InventoryWatcher) reportError(err error) {
+	if w.OnError != nil {
+		w.OnError(err)
+		return
+	}
+	log.Printf("Inventory watcher for %s: %v\n", w.SteamID, err)
+}
@@ -10,27 +10,88 @@ import (
 	"net/url"
 )
 
-// MarketItem represents an item to be listed on the Steam market
+// MarketItem represents an item to be listed on the Steam market.
+// Price is interpreted according to the PriceKind passed to ListMarketItem.
 type MarketItem struct {
-	AppID      int    `json:"appid"`
-	ContextID  int    `json:"contextid"`
-	AssetID    int    `json:"assetid"`
-	Price      int    `json:"price"`
-	Currency   string `json:"currency"`
-	Qty        int    `json:"qty"`
-	MarketName string `json:"market_name"`
+	AppID          int      `json:"appid"`
+	ContextID      int      `json:"contextid"`
+	AssetID        int      `json:"assetid"`
+	Price          int      `json:"price"`
+	Currency       Currency `json:"currency"`
+	Qty            int      `json:"qty"`
+	MarketName     string   `json:"market_name"`
+	MarketHashName string   `json:"market_hash_name"`
+}
+
+// SellItemResult represents the response from the market/sellitem call
+type SellItemResult struct {
+	Success                 bool   `json:"success"`
+	RequiresConfirmation    int    `json:"requires_confirmation"`
+	NeedsMobileConfirmation bool   `json:"needs_mobile_confirmation"`
+	NeedsEmailConfirmation  bool   `json:"needs_email_confirmation"`
+	EmailDomain             string `json:"email_domain"`
+	Message                 string `json:"message"`
 }
 
 // ListMarketItem lists an item on the Steam market
 // item: MarketItem struct containing item details
-func (b *Bot) ListMarketItem(item MarketItem) error {
+// kind: Whether item.Price is the price the buyer pays or the amount the seller receives
+func (b *Bot) ListMarketItem(item MarketItem, kind PriceKind) error {
+	_, err := b.listMarketItem(item, kind)
+	return err
+}
+
+// listingSellerReceives converts a listing price to the amount the seller receives, rejecting
+// invalid price kinds and prices below the market minimum
+// appID: Application ID of the item, used to look up the publisher fee
+// price: Listing price in hundredths
+// kind: Whether price is the price the buyer pays or the amount the seller receives
+func listingSellerReceives(appID, price int, kind PriceKind) (int, error) {
+	sellerReceives := price
+	switch kind {
+	case PriceSellerReceives:
+	case PriceBuyerPays:
+		sellerReceives = SellerReceives(appID, price)
+	default:
+		return 0, fmt.Errorf("invalid price kind: %d", kind)
+	}
+	if sellerReceives < 1 {
+		return 0, fmt.Errorf("price %d (%s) is below the market minimum: seller would receive %d", price, kind, sellerReceives)
+	}
+	return sellerReceives, nil
+}
+
+// listMarketItem lists an item on the Steam market and returns Steam's confirmation flags
+// item: MarketItem struct containing item details
+// kind: Whether item.Price is the price the buyer pays or the amount the seller receives
+func (b *Bot) listMarketItem(item MarketItem, kind PriceKind) (listed *SellItemResult, err error) {
+	// Steam expects the amount the seller receives
+	sellerReceives := item.Price
+	defer func() {
+		b.audit(AuditActionListMarketItem, map[string]interface{}{
+			"appid":           item.AppID,
+			"contextid":       item.ContextID,
+			"assetid":         item.AssetID,
+			"price":           item.Price,
+			"price_kind":      kind.String(),
+			"seller_receives": sellerReceives,
+			"currency":        item.Currency.String(),
+			"quantity":        item.Qty,
+		}, nil, err)
+	}()
+
+	sellerReceives, err = listingSellerReceives(item.AppID, item.Price, kind)
+	if err != nil {
+		return nil, err
+	}
+
 	data := url.Values{}
 	data.Set("sessionid", "your_session_id")
 	data.Set("appid", fmt.Sprintf("%d", item.AppID))
 	data.Set("contextid", fmt.Sprintf("%d", item.ContextID))
 	data.Set("assetid", fmt.Sprintf("%d", item.AssetID))
-	data.Set("price", fmt.Sprintf("%d", item.Price))
-	data.Set("currency", item.Currency)
+	data.Set("price", fmt.Sprintf("%d", sellerReceives))
+	data.Set("currency", fmt.Sprintf("%d", item.Currency.Code()))
 	data.Set("quantity", fmt.Sprintf("%d", item.Qty))
 	data.Set("market_name", item.MarketName)
 
@@ -38,13 +99,13 @@ func (b *Bot) ListMarketItem(item MarketItem) error {
 
 	req, err := http.NewRequest("POST", listMarketItemURL, bytes.NewBufferString(data.Encode()))
 	if err != nil {
-		return fmt.Errorf("failed to create list market item request: %w", err)
+		return nil, fmt.Errorf("failed to create list market item request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 
 	resp, err := b.Session.Do(req)
 	if err != nil {
-		return fmt.Errorf("failed to send list market item request: %w", err)
+		return nil, fmt.Errorf("failed to send list market item request: %w", err)
 	}
 	defer func(Body io.ReadCloser) {
 		err := Body.Close()
@@ -54,18 +115,20 @@ func (b *Bot) ListMarketItem(item MarketItem) error {
 	}(resp.Body)
 
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("steam API returned non-OK status: %s", resp.Status)
+		return nil, fmt.Errorf("steam API returned non-OK status: %s", resp.Status)
 	}
 
-	var result map[string]interface{}
+	var result SellItemResult
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return fmt.Errorf("failed to decode list market item response: %w", err)
+		return nil, fmt.Errorf("failed to decode list market item response: %w", err)
 	}
 
-	if !result["success"].(bool) {
-		return fmt.Errorf("listing market item failed: %v", result)
+	if !result.Success {
+		return nil, fmt.Errorf("listing market item failed: %s", result.Message)
 	}
 
+	InvalidateInventory(b.SteamID)
+
 	log.Printf("Market item listed: %+v\n", item)
-	return nil
+	return &result, nil
 }
@@ -0,0 +1,204 @@
+package steam
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+)
+
+// BuyOrder represents a buy order to be placed on the Steam market.
+// Price is the price per item the buyer pays, in hundredths of the currency unit.
+type BuyOrder struct {
+	AppID          int      `json:"appid"`
+	MarketHashName string   `json:"market_hash_name"`
+	Price          int      `json:"price"`
+	Quantity       int      `json:"quantity"`
+	Currency       Currency `json:"currency"`
+}
+
+// CreateBuyOrderResult represents the result of placing a buy order
+type CreateBuyOrderResult struct {
+	BuyOrderID string `json:"buy_orderid"`
+
+	// Confirmed is true if the order needed a mobile confirmation that was accepted
+	Confirmed bool `json:"confirmed"`
+}
+
+// BuyOrderPurchase represents an item bought through a buy order
+type BuyOrderPurchase struct {
+	ListingID  string      `json:"listingid"`
+	AppID      json.Number `json:"appid"`
+	ContextID  string      `json:"contextid"`
+	AssetID    string      `json:"assetid"`
+	PriceTotal json.Number `json:"price_total"`
+}
+
+// BuyOrderStatus represents the state of a buy order
+type BuyOrderStatus struct {
+	Active            bool               `json:"active"`
+	Purchased         int                `json:"purchased"`
+	Quantity          int                `json:"quantity"`
+	QuantityRemaining int                `json:"quantity_remaining"`
+	Purchases         []BuyOrderPurchase `json:"purchases"`
+}
+
+// createBuyOrderResponse represents the response from the market/createbuyorder call
+type createBuyOrderResponse struct {
+	Success          int    `json:"success"`
+	BuyOrderID       string `json:"buy_orderid"`
+	Message          string `json:"message"`
+	NeedConfirmation bool   `json:"need_confirmation"`
+	Confirmation     struct {
+		ConfirmationID string `json:"confirmation_id"`
+	} `json:"confirmation"`
+}
+
+// buyOrderStatusResponse represents the response from the market/getbuyorderstatus call
+type buyOrderStatusResponse struct {
+	Success           int                `json:"success"`
+	Active            int                `json:"active"`
+	Purchased         int                `json:"purchased"`
+	Quantity          json.Number        `json:"quantity"`
+	QuantityRemaining json.Number        `json:"quantity_remaining"`
+	Purchases         []BuyOrderPurchase `json:"purchases"`
+}
+
+// CreateBuyOrder places a buy order on the Steam market. If Steam requires a mobile
+// confirmation, it is accepted with the bot's identity secret and the order is resubmitted.
+// order: BuyOrder struct containing the order details
+func (b *Bot) CreateBuyOrder(order BuyOrder) (created *CreateBuyOrderResult, err error) {
+	defer func() {
+		var ids map[string]string
+		if created != nil {
+			ids = map[string]string{"buy_orderid": created.BuyOrderID}
+		}
+		b.audit(AuditActionCreateBuyOrder, map[string]interface{}{
+			"appid":            order.AppID,
+			"market_hash_name": order.MarketHashName,
+			"price":            order.Price,
+			"quantity":         order.Quantity,
+			"currency":         order.Currency.String(),
+		}, ids, err)
+	}()
+
+	if order.Quantity < 1 {
+		return nil, fmt.Errorf("invalid buy order quantity: %d", order.Quantity)
+	}
+
+	data := url.Values{}
+	data.Set("sessionid", "your_session_id")
+	data.Set("currency", strconv.Itoa(order.Currency.Code()))
+	data.Set("appid", strconv.Itoa(order.AppID))
+	data.Set("market_hash_name", order.MarketHashName)
+	data.Set("price_total", strconv.Itoa(order.Price*order.Quantity))
+	data.Set("quantity", strconv.Itoa(order.Quantity))
+	data.Set("billing_state", "")
+	data.Set("save_my_address", "0")
+
+	var result createBuyOrderResponse
+	err = postForm(b.Session, "https://steamcommunity.com/market/createbuyorder/", data, &result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create buy order: %w", err)
+	}
+
+	confirmed := false
+	if result.NeedConfirmation {
+		confirmationID := result.Confirmation.ConfirmationID
+		if err := b.acceptBuyOrderConfirmation(confirmationID); err != nil {
+			return nil, fmt.Errorf("failed to confirm buy order: %w", err)
+		}
+		confirmed = true
+
+		// Resubmit the order referencing the accepted confirmation
+		data.Set("confirmation", confirmationID)
+		result = createBuyOrderResponse{}
+		err = postForm(b.Session, "https://steamcommunity.com/market/createbuyorder/", data, &result)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create buy order: %w", err)
+		}
+	}
+
+	if result.Success != 1 || result.BuyOrderID == "" {
+		return nil, fmt.Errorf("creating buy order failed (%d): %s", result.Success, result.Message)
+	}
+
+	log.Printf("Buy order %s created for %s\n", result.BuyOrderID, order.MarketHashName)
+	return &CreateBuyOrderResult{BuyOrderID: result.BuyOrderID, Confirmed: confirmed}, nil
+}
+
+// acceptBuyOrderConfirmation accepts the mobile confirmation Steam created for a buy order
+// confirmationID: Confirmation ID returned by market/createbuyorder
+func (b *Bot) acceptBuyOrderConfirmation(confirmationID string) error {
+	confirmations, err := b.GetConfirmations()
+	if err != nil {
+		return err
+	}
+
+	for _, confirmation := range confirmations {
+		if confirmation.ID == confirmationID || confirmation.CreatorID == confirmationID {
+			return b.RespondToConfirmation(confirmation, true)
+		}
+	}
+
+	return fmt.Errorf("no confirmation found for buy order confirmation %s", confirmationID)
+}
+
+// CancelBuyOrder cancels an active buy order
+// buyOrderID: ID of the buy order to cancel
+func (b *Bot) CancelBuyOrder(buyOrderID string) (err error) {
+	defer func() {
+		b.audit(AuditActionCancelBuyOrder, nil, map[string]string{"buy_orderid": buyOrderID}, err)
+	}()
+
+	data := url.Values{}
+	data.Set("sessionid", "your_session_id")
+	data.Set("buy_orderid", buyOrderID)
+
+	var result struct {
+		Success int `json:"success"`
+	}
+	err = postForm(b.Session, "https://steamcommunity.com/market/cancelbuyorder/", data, &result)
+	if err != nil {
+		return fmt.Errorf("failed to cancel buy order: %w", err)
+	}
+
+	if result.Success != 1 {
+		return fmt.Errorf("cancelling buy order failed (%d)", result.Success)
+	}
+
+	log.Printf("Buy order %s cancelled\n", buyOrderID)
+	return nil
+}
+
+// GetBuyOrderStatus fetches the status of a buy order and the items purchased through it
+// buyOrderID: ID of the buy order
+func (b *Bot) GetBuyOrderStatus(buyOrderID string) (*BuyOrderStatus, error) {
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("sessionid", "your_session_id")
+	params.Set("buy_orderid", buyOrderID)
+
+	var result buyOrderStatusResponse
+	err := getJSON(b.Session, "https://steamcommunity.com/market/getbuyorderstatus/?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Success != 1 {
+		return nil, fmt.Errorf("buy order %s status not available (%d)", buyOrderID, result.Success)
+	}
+
+	quantity, _ := strconv.Atoi(result.Quantity.String())
+	remaining, _ := strconv.Atoi(result.QuantityRemaining.String())
+
+	return &BuyOrderStatus{
+		Active:            result.Active == 1,
+		Purchased:         result.Purchased,
+		Quantity:          quantity,
+		QuantityRemaining: remaining,
+		Purchases:         result.Purchases,
+	}, nil
+}
@@ -0,0 +1,148 @@
+package steam
+
+import (
+	"math"
+	"sync"
+)
+
+// PriceKind specifies whether a market price is what the buyer pays or what the seller receives
+type PriceKind int
+
+const (
+	// PriceSellerReceives is the amount credited to the seller after fees
+	PriceSellerReceives PriceKind = iota + 1
+	// PriceBuyerPays is the listed price including the Steam and publisher fees
+	PriceBuyerPays
+)
+
+func (k PriceKind) String() string {
+	switch k {
+	case PriceSellerReceives:
+		return "seller_receives"
+	case PriceBuyerPays:
+		return "buyer_pays"
+	default:
+		return "unknown"
+	}
+}
+
+// FeeParams holds the wallet fee parameters Steam uses to compute market fees
+type FeeParams struct {
+	FeePercent                 float64
+	FeeMinimum                 int
+	FeeBase                    int
+	PublisherFeePercentDefault float64
+}
+
+// DefaultFeeParams are the fee parameters Steam applies to most wallets
+var DefaultFeeParams = FeeParams{
+	FeePercent:                 0.05,
+	FeeMinimum:                 1,
+	FeeBase:                    0,
+	PublisherFeePercentDefault: 0.10,
+}
+
+// MarketFees represents the breakdown of a market price into the seller's share and the fees
+type MarketFees struct {
+	SteamFee       int `json:"steam_fee"`
+	PublisherFee   int `json:"publisher_fee"`
+	Fees           int `json:"fees"`
+	BuyerPays      int `json:"buyer_pays"`
+	SellerReceives int `json:"seller_receives"`
+}
+
+// publisherFees holds per-app publisher fee overrides
+var publisherFees = struct {
+	sync.RWMutex
+	fees map[int]float64
+}{fees: make(map[int]float64)}
+
+// SetPublisherFee overrides the publisher fee percentage for an app (e.g., 0.10 for 10%)
+// appID: Application ID
+// fee: Publisher fee as a fraction
+func SetPublisherFee(appID int, fee float64) {
+	publisherFees.Lock()
+	defer publisherFees.Unlock()
+	publisherFees.fees[appID] = fee
+}
+
+// PublisherFee returns the publisher fee percentage for an app, falling back to the default
+// appID: Application ID
+func PublisherFee(appID int) float64 {
+	publisherFees.RLock()
+	defer publisherFees.RUnlock()
+	if fee, ok := publisherFees.fees[appID]; ok {
+		return fee
+	}
+	return DefaultFeeParams.PublisherFeePercentDefault
+}
+
+// FromSellerReceives computes the fees and the buyer price for an amount the seller should receive,
+// mirroring Steam's CalculateAmountToSendForDesiredReceivedAmount
+// sellerReceives: Amount the seller receives in the smallest currency unit
+// publisherFee: Publisher fee as a fraction
+func (p FeeParams) FromSellerReceives(sellerReceives int, publisherFee float64) MarketFees {
+	steamFee := int(math.Floor(math.Max(float64(sellerReceives)*p.FeePercent, float64(p.FeeMinimum)) + float64(p.FeeBase)))
+
+	pubFee := 0
+	if publisherFee > 0 {
+		pubFee = int(math.Floor(math.Max(float64(sellerReceives)*publisherFee, 1)))
+	}
+
+	return MarketFees{
+		SteamFee:       steamFee,
+		PublisherFee:   pubFee,
+		Fees:           steamFee + pubFee,
+		BuyerPays:      sellerReceives + steamFee + pubFee,
+		SellerReceives: sellerReceives,
+	}
+}
+
+// FromBuyerPays computes the fees and the seller's share of a buyer price,
+// mirroring Steam's CalculateFeeAmount. Prices too low to cover the minimum fees
+// are clamped to a SellerReceives of 0, with the whole price counted as fees.
+// buyerPays: Price paid by the buyer in the smallest currency unit
+// publisherFee: Publisher fee as a fraction
+func (p FeeParams) FromBuyerPays(buyerPays int, publisherFee float64) MarketFees {
+	estimate := int((float64(buyerPays) - float64(p.FeeBase)) / (p.FeePercent + publisherFee + 1))
+	everUndershot := false
+	fees := p.FromSellerReceives(estimate, publisherFee)
+
+	for iterations := 0; fees.BuyerPays != buyerPays && iterations < 10; iterations++ {
+		if fees.BuyerPays > buyerPays {
+			if everUndershot {
+				// No seller amount produces this exact price; Steam keeps the difference as its fee
+				fees = p.FromSellerReceives(estimate-1, publisherFee)
+				fees.SteamFee += buyerPays - fees.BuyerPays
+				fees.Fees += buyerPays - fees.BuyerPays
+				fees.BuyerPays = buyerPays
+				break
+			}
+			estimate--
+		} else {
+			everUndershot = true
+			estimate++
+		}
+		fees = p.FromSellerReceives(estimate, publisherFee)
+	}
+
+	if fees.SellerReceives < 0 {
+		fees = MarketFees{SteamFee: buyerPays, Fees: buyerPays, BuyerPays: buyerPays}
+	}
+
+	return fees
+}
+
+// BuyerPays returns the price a buyer pays for an item when the seller receives the given amount
+// appID: Application ID of the item, used to look up the publisher fee
+// sellerReceives: Amount the seller receives in the smallest currency unit
+func BuyerPays(appID, sellerReceives int) int {
+	return DefaultFeeParams.FromSellerReceives(sellerReceives, PublisherFee(appID)).BuyerPays
+}
+
+// SellerReceives returns the amount a seller receives for an item sold at the given buyer price
+// appID: Application ID of the item, used to look up the publisher fee
+// buyerPays: Price paid by the buyer in the smallest currency unit
+func SellerReceives(appID, buyerPays int) int {
+	return DefaultFeeParams.FromBuyerPays(buyerPays, PublisherFee(appID)).SellerReceives
+}
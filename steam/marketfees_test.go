@@ -0,0 +1,57 @@
+package steam
+
+import "testing"
+
+func TestFromBuyerPays(t *testing.T) {
+	tests := []struct {
+		buyerPays      int
+		sellerReceives int
+	}{
+		{1, 0},
+		{2, 0},
+		{3, 1},
+		{115, 100},
+		{1000, 870},
+	}
+
+	for _, tt := range tests {
+		fees := DefaultFeeParams.FromBuyerPays(tt.buyerPays, DefaultFeeParams.PublisherFeePercentDefault)
+		if fees.SellerReceives != tt.sellerReceives {
+			t.Errorf("FromBuyerPays(%d).SellerReceives = %d, want %d", tt.buyerPays, fees.SellerReceives, tt.sellerReceives)
+		}
+		if fees.SellerReceives+fees.Fees != tt.buyerPays {
+			t.Errorf("FromBuyerPays(%d): seller %d + fees %d != buyer price", tt.buyerPays, fees.SellerReceives, fees.Fees)
+		}
+	}
+}
+
+func TestFromSellerReceives(t *testing.T) {
+	fees := DefaultFeeParams.FromSellerReceives(100, DefaultFeeParams.PublisherFeePercentDefault)
+	want := MarketFees{SteamFee: 5, PublisherFee: 10, Fees: 15, BuyerPays: 115, SellerReceives: 100}
+	if fees != want {
+		t.Errorf("FromSellerReceives(100) = %+v, want %+v", fees, want)
+	}
+
+	// Both fees have a minimum of 1
+	fees = DefaultFeeParams.FromSellerReceives(1, DefaultFeeParams.PublisherFeePercentDefault)
+	if fees.BuyerPays != 3 {
+		t.Errorf("FromSellerReceives(1).BuyerPays = %d, want 3", fees.BuyerPays)
+	}
+}
+
+func TestFeeRoundTrip(t *testing.T) {
+	for sellerReceives := 1; sellerReceives < 20000; sellerReceives++ {
+		buyerPays := BuyerPays(730, sellerReceives)
+		if got := SellerReceives(730, buyerPays); got != sellerReceives {
+			t.Fatalf("SellerReceives(BuyerPays(%d) = %d) = %d", sellerReceives, buyerPays, got)
+		}
+	}
+}
+
+func TestFromBuyerPaysNeverNegative(t *testing.T) {
+	for buyerPays := 0; buyerPays < 10; buyerPays++ {
+		if fees := DefaultFeeParams.FromBuyerPays(buyerPays, DefaultFeeParams.PublisherFeePercentDefault); fees.SellerReceives < 0 {
+			t.Errorf("FromBuyerPays(%d).SellerReceives = %d", buyerPays, fees.SellerReceives)
+		}
+	}
+}
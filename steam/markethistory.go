@@ -0,0 +1,290 @@
+package steam
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// MarketHistoryEventType represents the kind of market history event
+type MarketHistoryEventType int
+
+const (
+	MarketHistoryListed    MarketHistoryEventType = 1
+	MarketHistoryCancelled MarketHistoryEventType = 2
+	MarketHistorySold      MarketHistoryEventType = 3
+	MarketHistoryPurchased MarketHistoryEventType = 4
+)
+
+func (t MarketHistoryEventType) String() string {
+	switch t {
+	case MarketHistoryListed:
+		return "listed"
+	case MarketHistoryCancelled:
+		return "cancelled"
+	case MarketHistorySold:
+		return "sold"
+	case MarketHistoryPurchased:
+		return "purchased"
+	default:
+		return "unknown"
+	}
+}
+
+// MarketHistoryEvent represents a single event from the bot's market history.
+// BuyerPays is in hundredths of BuyerCurrency and SellerReceives in hundredths of SellerCurrency;
+// the two differ when buyer and seller use different wallet currencies.
+type MarketHistoryEvent struct {
+	Type           MarketHistoryEventType `json:"type"`
+	Time           time.Time              `json:"time"`
+	ListingID      string                 `json:"listingid"`
+	PurchaseID     string                 `json:"purchaseid,omitempty"`
+	AppID          int                    `json:"appid"`
+	ContextID      string                 `json:"contextid"`
+	AssetID        string                 `json:"assetid"`
+	Amount         int                    `json:"amount"`
+	MarketHashName string                 `json:"market_hash_name"`
+	BuyerPays      int                    `json:"buyer_pays"`
+	SellerReceives int                    `json:"seller_receives"`
+	BuyerCurrency  Currency               `json:"buyer_currency"`
+	SellerCurrency Currency               `json:"seller_currency"`
+	Counterparty   string                 `json:"counterparty,omitempty"`
+}
+
+// MarketHistoryPage represents a page of the bot's market history
+type MarketHistoryPage struct {
+	Start      int
+	PageSize   int
+	TotalCount int
+	Events     []MarketHistoryEvent
+}
+
+// marketHistoryAsset represents an asset in the market/myhistory response
+type marketHistoryAsset struct {
+	AppID          int    `json:"appid"`
+	ContextID      string `json:"contextid"`
+	ID             string `json:"id"`
+	Amount         string `json:"amount"`
+	MarketHashName string `json:"market_hash_name"`
+}
+
+// marketHistoryResponse represents the response from the market/myhistory/render call.
+// Steam returns empty JSON arrays instead of objects for empty maps, so those are decoded lazily.
+type marketHistoryResponse struct {
+	Success    bool            `json:"success"`
+	PageSize   int             `json:"pagesize"`
+	TotalCount int             `json:"total_count"`
+	Start      int             `json:"start"`
+	Assets     json.RawMessage `json:"assets"`
+	Events     []struct {
+		ListingID    string                 `json:"listingid"`
+		PurchaseID   string                 `json:"purchaseid"`
+		EventType    MarketHistoryEventType `json:"event_type"`
+		TimeEvent    int64                  `json:"time_event"`
+		SteamIDActor string                 `json:"steamid_actor"`
+	} `json:"events"`
+	Purchases json.RawMessage `json:"purchases"`
+	Listings  json.RawMessage `json:"listings"`
+}
+
+// marketHistoryListing represents a listing in the market/myhistory response
+type marketHistoryListing struct {
+	ListingID  string             `json:"listingid"`
+	Price      int                `json:"price"`
+	Fee        int                `json:"fee"`
+	CurrencyID json.Number        `json:"currencyid"`
+	Asset      marketHistoryAsset `json:"asset"`
+}
+
+// marketHistoryPurchase represents a purchase in the market/myhistory response
+type marketHistoryPurchase struct {
+	ListingID          string             `json:"listingid"`
+	PurchaseID         string             `json:"purchaseid"`
+	SteamIDPurchaser   string             `json:"steamid_purchaser"`
+	PaidAmount         int                `json:"paid_amount"`
+	PaidFee            int                `json:"paid_fee"`
+	CurrencyID         json.Number        `json:"currencyid"`
+	ReceivedAmount     int                `json:"received_amount"`
+	ReceivedCurrencyID json.Number        `json:"received_currencyid"`
+	Asset              marketHistoryAsset `json:"asset"`
+}
+
+// currencyFromID converts a market currency ID (wallet currency code offset by 2000) to a Currency
+// id: Market currency ID, e.g. "2001" for USD
+func currencyFromID(id json.Number) Currency {
+	n, _ := strconv.Atoi(id.String())
+	return Currency(n % 2000)
+}
+
+// decodeObject decodes a JSON object into v, treating an empty array as an empty object
+// data: Raw JSON value
+// v: Pointer to the value the object is decoded into
+func decodeObject(data json.RawMessage, v interface{}) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || data[0] != '{' {
+		return nil
+	}
+	return json.Unmarshal(data, v)
+}
+
+// GetMarketHistory fetches a page of the bot's market history
+// start: Offset of the first event to return
+// count: Number of events to return (at most 500)
+func (b *Bot) GetMarketHistory(start, count int) (*MarketHistoryPage, error) {
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("query", "")
+	params.Set("start", strconv.Itoa(start))
+	params.Set("count", strconv.Itoa(count))
+	params.Set("norender", "1")
+
+	var result marketHistoryResponse
+	err := getJSON(b.Session, "https://steamcommunity.com/market/myhistory/render/?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return nil, fmt.Errorf("fetching market history failed")
+	}
+
+	assets := make(map[string]map[string]map[string]marketHistoryAsset)
+	listings := make(map[string]marketHistoryListing)
+	purchases := make(map[string]marketHistoryPurchase)
+	if err := decodeObject(result.Assets, &assets); err != nil {
+		return nil, fmt.Errorf("failed to decode market history assets: %w", err)
+	}
+	if err := decodeObject(result.Listings, &listings); err != nil {
+		return nil, fmt.Errorf("failed to decode market history listings: %w", err)
+	}
+	if err := decodeObject(result.Purchases, &purchases); err != nil {
+		return nil, fmt.Errorf("failed to decode market history purchases: %w", err)
+	}
+
+	page := &MarketHistoryPage{
+		Start:      result.Start,
+		PageSize:   result.PageSize,
+		TotalCount: result.TotalCount,
+		Events:     make([]MarketHistoryEvent, 0, len(result.Events)),
+	}
+
+	for _, e := range result.Events {
+		event := MarketHistoryEvent{
+			Type:       e.EventType,
+			Time:       time.Unix(e.TimeEvent, 0).UTC(),
+			ListingID:  e.ListingID,
+			PurchaseID: e.PurchaseID,
+		}
+
+		var asset marketHistoryAsset
+		switch e.EventType {
+		case MarketHistorySold, MarketHistoryPurchased:
+			purchase := purchases[e.ListingID+"_"+e.PurchaseID]
+			asset = purchase.Asset
+			event.BuyerPays = purchase.PaidAmount + purchase.PaidFee
+			event.SellerReceives = purchase.ReceivedAmount
+			event.BuyerCurrency = currencyFromID(purchase.CurrencyID)
+			event.SellerCurrency = currencyFromID(purchase.ReceivedCurrencyID)
+			if e.EventType == MarketHistorySold {
+				event.Counterparty = purchase.SteamIDPurchaser
+			} else {
+				event.Counterparty = e.SteamIDActor
+			}
+		default:
+			listing := listings[e.ListingID]
+			asset = listing.Asset
+			event.BuyerPays = listing.Price + listing.Fee
+			event.SellerReceives = listing.Price
+			// Listing prices and fees are both in the seller's currency
+			event.BuyerCurrency = currencyFromID(listing.CurrencyID)
+			event.SellerCurrency = event.BuyerCurrency
+		}
+
+		event.AppID = asset.AppID
+		event.ContextID = asset.ContextID
+		event.AssetID = asset.ID
+		event.Amount, _ = strconv.Atoi(asset.Amount)
+		event.MarketHashName = asset.MarketHashName
+		if full, ok := assets[strconv.Itoa(asset.AppID)][asset.ContextID][asset.ID]; ok {
+			event.MarketHashName = full.MarketHashName
+		}
+
+		page.Events = append(page.Events, event)
+	}
+
+	return page, nil
+}
+
+// GetAllMarketHistory fetches the bot's complete market history, page by page
+// pageSize: Number of events to request per page (at most 500)
+func (b *Bot) GetAllMarketHistory(pageSize int) ([]MarketHistoryEvent, error) {
+	var events []MarketHistoryEvent
+	for start := 0; ; {
+		page, err := b.GetMarketHistory(start, pageSize)
+		if err != nil {
+			return events, err
+		}
+
+		events = append(events, page.Events...)
+		start += len(page.Events)
+		if len(page.Events) == 0 || start >= page.TotalCount {
+			return events, nil
+		}
+	}
+}
+
+// ExportMarketHistoryCSV writes market history events as CSV with a header row
+// w: Writer the CSV is written to
+// events: Events to export
+func ExportMarketHistoryCSV(w io.Writer, events []MarketHistoryEvent) error {
+	writer := csv.NewWriter(w)
+	header := []string{"time", "type", "listingid", "purchaseid", "appid", "contextid", "assetid", "amount",
+		"market_hash_name", "buyer_pays", "buyer_currency", "seller_receives", "seller_currency", "counterparty"}
+	if err := writer.Write(header); err != nil {
+		return fmt.Errorf("failed to write CSV header: %w", err)
+	}
+
+	for _, e := range events {
+		record := []string{
+			e.Time.Format(time.RFC3339),
+			e.Type.String(),
+			e.ListingID,
+			e.PurchaseID,
+			strconv.Itoa(e.AppID),
+			e.ContextID,
+			e.AssetID,
+			strconv.Itoa(e.Amount),
+			e.MarketHashName,
+			strconv.Itoa(e.BuyerPays),
+			e.BuyerCurrency.String(),
+			strconv.Itoa(e.SellerReceives),
+			e.SellerCurrency.String(),
+			e.Counterparty,
+		}
+		if err := writer.Write(record); err != nil {
+			return fmt.Errorf("failed to write CSV record: %w", err)
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// ExportMarketHistoryJSONLines writes market history events as JSON Lines
+// w: Writer the events are written to
+// events: Events to export
+func ExportMarketHistoryJSONLines(w io.Writer, events []MarketHistoryEvent) error {
+	encoder := json.NewEncoder(w)
+	for _, e := range events {
+		if err := encoder.Encode(e); err != nil {
+			return fmt.Errorf("failed to write market history event: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+package steam
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/url"
+	"strconv"
+)
+
+// MarketListingAsset represents the item attached to a market listing
+type MarketListingAsset struct {
+	Currency       int    `json:"currency"`
+	AppID          int    `json:"appid"`
+	ContextID      string `json:"contextid"`
+	ID             string `json:"id"`
+	Amount         string `json:"amount"`
+	ClassID        string `json:"classid"`
+	InstanceID     string `json:"instanceid"`
+	Name           string `json:"name"`
+	MarketHashName string `json:"market_hash_name"`
+}
+
+// MarketListing represents one of the bot's own market listings.
+// Price is the amount the seller receives and Fee the fees on top of it, in hundredths.
+type MarketListing struct {
+	ListingID     string             `json:"listingid"`
+	TimeCreated   int64              `json:"time_created"`
+	Asset         MarketListingAsset `json:"asset"`
+	SteamIDLister string             `json:"steamid_lister"`
+	Price         int                `json:"price"`
+	OriginalPrice int                `json:"original_price"`
+	Fee           int                `json:"fee"`
+	CurrencyID    json.Number        `json:"currencyid"`
+	Status        int                `json:"status"`
+	Active        int                `json:"active"`
+}
+
+// WalletCurrency returns the currency the listing is priced in
+func (l *MarketListing) WalletCurrency() Currency {
+	return currencyFromID(l.CurrencyID)
+}
+
+// MarketBuyOrderListing represents one of the bot's active buy orders.
+// Price is the price per item in hundredths of the wallet currency.
+type MarketBuyOrderListing struct {
+	BuyOrderID        string      `json:"buy_orderid"`
+	AppID             int         `json:"appid"`
+	HashName          string      `json:"hash_name"`
+	WalletCurrency    Currency    `json:"wallet_currency"`
+	Price             json.Number `json:"price"`
+	Quantity          json.Number `json:"quantity"`
+	QuantityRemaining json.Number `json:"quantity_remaining"`
+}
+
+// MyListings represents a page of the bot's market listings and buy orders
+type MyListings struct {
+	Success           bool                    `json:"success"`
+	PageSize          int                     `json:"pagesize"`
+	TotalCount        int                     `json:"total_count"`
+	Start             int                     `json:"start"`
+	NumActiveListings int                     `json:"num_active_listings"`
+	Listings          []MarketListing         `json:"listings"`
+	ListingsOnHold    []MarketListing         `json:"listings_on_hold"`
+	ListingsToConfirm []MarketListing         `json:"listings_to_confirm"`
+	BuyOrders         []MarketBuyOrderListing `json:"buy_orders"`
+}
+
+// NextStart returns the start offset of the next page of active listings, or false if this is the last page
+func (l *MyListings) NextStart() (int, bool) {
+	next := l.Start + len(l.Listings)
+	if len(l.Listings) == 0 || next >= l.TotalCount {
+		return 0, false
+	}
+	return next, true
+}
+
+// GetMyListings fetches a page of the bot's active listings, listings on hold or awaiting
+// confirmation, and buy orders
+// start: Offset of the first active listing to return
+// count: Number of active listings to return (at most 100)
+func (b *Bot) GetMyListings(start, count int) (*MyListings, error) {
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("start", strconv.Itoa(start))
+	params.Set("count", strconv.Itoa(count))
+	params.Set("norender", "1")
+
+	var result MyListings
+	err := getJSON(b.Session, "https://steamcommunity.com/market/mylistings?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return nil, fmt.Errorf("fetching market listings failed")
+	}
+
+	return &result, nil
+}
+
+// RemoveListing removes one of the bot's market listings, returning the item to the inventory
+// listingID: ID of the listing to remove
+func (b *Bot) RemoveListing(listingID string) (err error) {
+	defer func() {
+		b.audit(AuditActionRemoveListing, nil, map[string]string{"listingid": listingID}, err)
+	}()
+
+	data := url.Values{}
+	data.Set("sessionid", "your_session_id")
+
+	err = postForm(b.Session, "https://steamcommunity.com/market/removelisting/"+url.PathEscape(listingID), data, nil)
+	if err != nil {
+		return fmt.Errorf("failed to remove market listing: %w", err)
+	}
+
+	InvalidateInventory(b.SteamID)
+
+	log.Printf("Market listing %s removed\n", listingID)
+	return nil
+}
+
+// Relist reprices an active listing by removing it and listing the same asset again.
+// The new price is checked before the listing is removed, and the asset is looked up in the
+// bot's inventory afterwards, since returned stackable items may be merged under another asset ID.
+// listing: Listing to reprice
+// price: New price
+// kind: Whether price is the price the buyer pays or the amount the seller receives
+func (b *Bot) Relist(listing MarketListing, price int, kind PriceKind) error {
+	if b.SteamID == "" {
+		return fmt.Errorf("bot SteamID is not set")
+	}
+	contextID, err := strconv.Atoi(listing.Asset.ContextID)
+	if err != nil {
+		return fmt.Errorf("invalid context ID %q: %w", listing.Asset.ContextID, err)
+	}
+	amount, err := strconv.Atoi(listing.Asset.Amount)
+	if err != nil || amount < 1 {
+		amount = 1
+	}
+	if _, err := listingSellerReceives(listing.Asset.AppID, price, kind); err != nil {
+		return err
+	}
+
+	if err := b.RemoveListing(listing.ListingID); err != nil {
+		return err
+	}
+
+	assetID, err := b.findReturnedAsset(listing.Asset, contextID, amount)
+	if err != nil {
+		return fmt.Errorf("listing %s was removed but the item could not be relisted: %w", listing.ListingID, err)
+	}
+
+	item := MarketItem{
+		AppID:          listing.Asset.AppID,
+		ContextID:      contextID,
+		AssetID:        assetID,
+		Price:          price,
+		Currency:       listing.WalletCurrency(),
+		Qty:            amount,
+		MarketName:     listing.Asset.MarketHashName,
+		MarketHashName: listing.Asset.MarketHashName,
+	}
+	if err := b.ListMarketItem(item, kind); err != nil {
+		return fmt.Errorf("listing %s was removed but relisting failed: %w", listing.ListingID, err)
+	}
+
+	return nil
+}
+
+// findReturnedAsset finds the asset a removed listing returned to the bot's inventory:
+// the listed asset ID if it is back, otherwise a stack of the same class holding enough items
+// asset: Asset of the removed listing
+// contextID: Context ID of the asset
+// amount: Number of items that were listed
+func (b *Bot) findReturnedAsset(asset MarketListingAsset, contextID, amount int) (int, error) {
+	inventory, err := CollectInventory(NewInventoryIterator(b.SteamID, asset.AppID, contextID))
+	if err != nil {
+		return 0, fmt.Errorf("failed to reload inventory: %w", err)
+	}
+
+	var stack *InventoryAsset
+	for i, owned := range inventory.Assets {
+		if owned.AssetID == asset.ID {
+			stack = &inventory.Assets[i]
+			break
+		}
+		if stack == nil && owned.ClassID == asset.ClassID && owned.InstanceID == asset.InstanceID {
+			if have, _ := strconv.Atoi(owned.Amount); have >= amount {
+				stack = &inventory.Assets[i]
+			}
+		}
+	}
+	if stack == nil {
+		return 0, fmt.Errorf("asset %s is not back in the inventory", asset.ID)
+	}
+
+	assetID, err := strconv.Atoi(stack.AssetID)
+	if err != nil {
+		return 0, fmt.Errorf("invalid asset ID %q: %w", stack.AssetID, err)
+	}
+	return assetID, nil
+}
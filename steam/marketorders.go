@@ -0,0 +1,149 @@
+package steam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strconv"
+	"sync"
+)
+
+// itemNameIDRegexp extracts the item_nameid from a market listing page
+var itemNameIDRegexp = regexp.MustCompile(`Market_LoadOrderSpread\(\s*(\d+)\s*\)`)
+
+// itemNameIDKey identifies a market item for the item_nameid cache
+type itemNameIDKey struct {
+	appID          int
+	marketHashName string
+}
+
+// itemNameIDCache caches item_nameid lookups; the IDs never change for a given item
+var itemNameIDCache = struct {
+	sync.Mutex
+	ids map[itemNameIDKey]string
+}{ids: make(map[itemNameIDKey]string)}
+
+// OrderGraphPoint represents a single price level in a buy or sell order graph.
+// Price is in whole currency units, as returned by Steam.
+type OrderGraphPoint struct {
+	Price       float64 `json:"price"`
+	Quantity    int     `json:"quantity"`
+	Description string  `json:"description"`
+}
+
+// UnmarshalJSON decodes an order graph point from Steam's [price, quantity, description] array
+func (p *OrderGraphPoint) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("invalid order graph point: %s", data)
+	}
+
+	if err := json.Unmarshal(raw[0], &p.Price); err != nil {
+		return fmt.Errorf("invalid order graph price: %w", err)
+	}
+	if err := json.Unmarshal(raw[1], &p.Quantity); err != nil {
+		return fmt.Errorf("invalid order graph quantity: %w", err)
+	}
+	if err := json.Unmarshal(raw[2], &p.Description); err != nil {
+		return fmt.Errorf("invalid order graph description: %w", err)
+	}
+	return nil
+}
+
+// ItemOrdersHistogram represents the response from the market/itemordershistogram call.
+// HighestBuyOrder and LowestSellOrder are in the smallest currency unit (e.g. cents).
+type ItemOrdersHistogram struct {
+	Success         int               `json:"success"`
+	HighestBuyOrder string            `json:"highest_buy_order"`
+	LowestSellOrder string            `json:"lowest_sell_order"`
+	BuyOrderGraph   []OrderGraphPoint `json:"buy_order_graph"`
+	SellOrderGraph  []OrderGraphPoint `json:"sell_order_graph"`
+	PricePrefix     string            `json:"price_prefix"`
+	PriceSuffix     string            `json:"price_suffix"`
+
+	// Currency is the currency the prices are in
+	Currency Currency `json:"-"`
+}
+
+// HighestBuyPrice returns the highest buy order price, or 0 if there are no buy orders
+func (h *ItemOrdersHistogram) HighestBuyPrice() int {
+	price, _ := strconv.Atoi(h.HighestBuyOrder)
+	return price
+}
+
+// LowestSellPrice returns the lowest sell order price, or 0 if there are no sell orders
+func (h *ItemOrdersHistogram) LowestSellPrice() int {
+	price, _ := strconv.Atoi(h.LowestSellOrder)
+	return price
+}
+
+// GetItemNameID looks up the item_nameid of a market item, scraping the listing page on the first call
+// appID: Application ID (e.g., 730 for CS:GO)
+// marketHashName: Market hash name of the item
+func (b *Bot) GetItemNameID(appID int, marketHashName string) (string, error) {
+	key := itemNameIDKey{appID, marketHashName}
+
+	itemNameIDCache.Lock()
+	id, ok := itemNameIDCache.ids[key]
+	itemNameIDCache.Unlock()
+	if ok {
+		return id, nil
+	}
+
+	rateLimiter.Wait()
+
+	listingURL := fmt.Sprintf("https://steamcommunity.com/market/listings/%d/%s", appID, url.PathEscape(marketHashName))
+	body, err := getBody(b.Session, listingURL)
+	if err != nil {
+		return "", err
+	}
+
+	match := itemNameIDRegexp.FindSubmatch(body)
+	if match == nil {
+		return "", fmt.Errorf("item_nameid not found for %s", marketHashName)
+	}
+	id = string(match[1])
+
+	itemNameIDCache.Lock()
+	itemNameIDCache.ids[key] = id
+	itemNameIDCache.Unlock()
+
+	return id, nil
+}
+
+// GetItemOrdersHistogram fetches the buy and sell order book of a market item
+// appID: Application ID (e.g., 730 for CS:GO)
+// marketHashName: Market hash name of the item
+// currency: Currency the prices are returned in
+func (b *Bot) GetItemOrdersHistogram(appID int, marketHashName string, currency Currency) (*ItemOrdersHistogram, error) {
+	itemNameID, err := b.GetItemNameID(appID, marketHashName)
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("country", "US")
+	params.Set("language", "english")
+	params.Set("currency", strconv.Itoa(currency.Code()))
+	params.Set("item_nameid", itemNameID)
+	params.Set("two_factor", "0")
+
+	var result ItemOrdersHistogram
+	err = getJSON(b.Session, "https://steamcommunity.com/market/itemordershistogram?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Success != 1 {
+		return nil, fmt.Errorf("item orders histogram not available for %s", marketHashName)
+	}
+	result.Currency = currency
+
+	return &result, nil
+}
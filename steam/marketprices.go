@@ -0,0 +1,154 @@
+package steam
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// PriceOverview represents the response from the market/priceoverview call
+type PriceOverview struct {
+	Success     bool   `json:"success"`
+	LowestPrice string `json:"lowest_price"`
+	Volume      string `json:"volume"`
+	MedianPrice string `json:"median_price"`
+
+	// Currency is the currency the prices are formatted in
+	Currency Currency `json:"-"`
+}
+
+// LowestPriceAmount returns the lowest listing price in hundredths of the currency unit
+func (p *PriceOverview) LowestPriceAmount() (int, error) {
+	return p.Currency.ParsePrice(p.LowestPrice)
+}
+
+// MedianPriceAmount returns the median sale price in hundredths of the currency unit
+func (p *PriceOverview) MedianPriceAmount() (int, error) {
+	return p.Currency.ParsePrice(p.MedianPrice)
+}
+
+// VolumeCount returns the number of items sold in the last 24 hours
+func (p *PriceOverview) VolumeCount() int {
+	volume, _ := strconv.Atoi(strings.NewReplacer(",", "", ".", "", " ", "").Replace(p.Volume))
+	return volume
+}
+
+// PricePoint represents a single point in an item's market price history.
+// Price is in whole currency units, as returned by Steam.
+type PricePoint struct {
+	Time   time.Time `json:"time"`
+	Price  float64   `json:"price"`
+	Volume int       `json:"volume"`
+}
+
+// priceHistoryDateLayout is the layout of the dates in market/pricehistory, e.g. "Jul 02 2014 01: +0"
+const priceHistoryDateLayout = "Jan 02 2006 15"
+
+// UnmarshalJSON decodes a price history point from Steam's [date, price, volume] array
+func (p *PricePoint) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	if len(raw) != 3 {
+		return fmt.Errorf("invalid price history point: %s", data)
+	}
+
+	var date, volume string
+	if err := json.Unmarshal(raw[0], &date); err != nil {
+		return fmt.Errorf("invalid price history date: %w", err)
+	}
+	if err := json.Unmarshal(raw[1], &p.Price); err != nil {
+		return fmt.Errorf("invalid price history price: %w", err)
+	}
+	if err := json.Unmarshal(raw[2], &volume); err != nil {
+		return fmt.Errorf("invalid price history volume: %w", err)
+	}
+
+	// The hour is followed by a stray colon and a UTC offset that is always "+0"
+	date = strings.TrimSpace(strings.SplitN(date, ":", 2)[0])
+	t, err := time.Parse(priceHistoryDateLayout, date)
+	if err != nil {
+		return fmt.Errorf("invalid price history date %q: %w", date, err)
+	}
+	p.Time = t
+
+	p.Volume, err = strconv.Atoi(volume)
+	if err != nil {
+		return fmt.Errorf("invalid price history volume %q: %w", volume, err)
+	}
+
+	return nil
+}
+
+// Amount returns the price in hundredths of the currency unit
+func (p *PricePoint) Amount() int {
+	return int(math.Round(p.Price * 100))
+}
+
+// PriceHistory represents the response from the market/pricehistory call
+type PriceHistory struct {
+	Success     bool         `json:"success"`
+	PricePrefix string       `json:"price_prefix"`
+	PriceSuffix string       `json:"price_suffix"`
+	Prices      []PricePoint `json:"prices"`
+
+	// Currency is the currency the prices are in
+	Currency Currency `json:"-"`
+}
+
+// GetPriceOverview fetches the lowest price, median price and volume of a market item
+// appID: Application ID (e.g., 730 for CS:GO)
+// marketHashName: Market hash name of the item
+// currency: Currency the prices are returned in
+func (b *Bot) GetPriceOverview(appID int, marketHashName string, currency Currency) (*PriceOverview, error) {
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("appid", strconv.Itoa(appID))
+	params.Set("market_hash_name", marketHashName)
+	params.Set("currency", strconv.Itoa(currency.Code()))
+
+	var result PriceOverview
+	err := getJSON(b.Session, "https://steamcommunity.com/market/priceoverview/?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return nil, fmt.Errorf("price overview not available for %s", marketHashName)
+	}
+	result.Currency = currency
+
+	return &result, nil
+}
+
+// GetPriceHistory fetches the median sale price history of a market item; requires a logged-in session
+// appID: Application ID (e.g., 730 for CS:GO)
+// marketHashName: Market hash name of the item
+// currency: Currency the prices are returned in
+func (b *Bot) GetPriceHistory(appID int, marketHashName string, currency Currency) (*PriceHistory, error) {
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("appid", strconv.Itoa(appID))
+	params.Set("market_hash_name", marketHashName)
+	params.Set("currency", strconv.Itoa(currency.Code()))
+
+	var result PriceHistory
+	err := getJSON(b.Session, "https://steamcommunity.com/market/pricehistory/?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return nil, fmt.Errorf("price history not available for %s", marketHashName)
+	}
+	result.Currency = currency
+
+	return &result, nil
+}
@@ -0,0 +1,223 @@
+package steam
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sort"
+	"strconv"
+)
+
+// MarketSearchOptions holds the parameters for a market search
+type MarketSearchOptions struct {
+	Query              string
+	AppID              int
+	SearchDescriptions bool
+
+	// Tags filters by tag, keyed by tag category, e.g. {"Exterior": {"tag_WearCategory0"}}
+	Tags map[string][]string
+
+	// SortColumn is one of "popular", "price", "quantity" or "name"
+	SortColumn string
+	// SortDir is "asc" or "desc"
+	SortDir string
+
+	Start int
+	Count int
+}
+
+// MarketSearchResult represents a single item returned by a market search.
+// SellPrice is the lowest listing price in hundredths of Currency, the searching wallet's currency,
+// which is detected from SellPriceText and left 0 if it cannot be recognized.
+type MarketSearchResult struct {
+	Name             string `json:"name"`
+	HashName         string `json:"hash_name"`
+	SellListings     int    `json:"sell_listings"`
+	SellPrice        int    `json:"sell_price"`
+	SellPriceText    string `json:"sell_price_text"`
+	SalePriceText    string `json:"sale_price_text"`
+	AppIcon          string `json:"app_icon"`
+	AppName          string `json:"app_name"`
+	AssetDescription struct {
+		AppID          int    `json:"appid"`
+		ClassID        string `json:"classid"`
+		InstanceID     string `json:"instanceid"`
+		IconURL        string `json:"icon_url"`
+		Tradable       int    `json:"tradable"`
+		Name           string `json:"name"`
+		MarketName     string `json:"market_name"`
+		MarketHashName string `json:"market_hash_name"`
+		Type           string `json:"type"`
+		Commodity      int    `json:"commodity"`
+	} `json:"asset_description"`
+
+	// Currency is filled in by SearchMarket
+	Currency Currency `json:"-"`
+}
+
+// MarketSearchResponse represents the response from the market/search/render call
+type MarketSearchResponse struct {
+	Success    bool                 `json:"success"`
+	Start      int                  `json:"start"`
+	PageSize   int                  `json:"pagesize"`
+	TotalCount int                  `json:"total_count"`
+	Results    []MarketSearchResult `json:"results"`
+}
+
+// MarketAction represents an action link attached to an item, such as an inspect link
+type MarketAction struct {
+	Link string `json:"link"`
+	Name string `json:"name"`
+}
+
+// ItemListing represents an individual sell listing of a market item.
+// Price and Fee are in the lister's currency; the Converted fields are in the requested currency.
+type ItemListing struct {
+	ListingID           string      `json:"listingid"`
+	Price               int         `json:"price"`
+	Fee                 int         `json:"fee"`
+	CurrencyID          json.Number `json:"currencyid"`
+	ConvertedPrice      int         `json:"converted_price"`
+	ConvertedFee        int         `json:"converted_fee"`
+	ConvertedCurrencyID json.Number `json:"converted_currencyid"`
+	Asset               struct {
+		AppID         int            `json:"appid"`
+		ContextID     string         `json:"contextid"`
+		ID            string         `json:"id"`
+		Amount        string         `json:"amount"`
+		MarketActions []MarketAction `json:"market_actions"`
+	} `json:"asset"`
+}
+
+// Currency returns the lister's currency, which Price and Fee are in
+func (l *ItemListing) Currency() Currency {
+	return currencyFromID(l.CurrencyID)
+}
+
+// ConvertedCurrency returns the requested currency, which the Converted fields are in
+func (l *ItemListing) ConvertedCurrency() Currency {
+	return currencyFromID(l.ConvertedCurrencyID)
+}
+
+// BuyerPays returns the total price of the listing in the requested currency, in hundredths
+func (l *ItemListing) BuyerPays() int {
+	return l.ConvertedPrice + l.ConvertedFee
+}
+
+// ItemListings represents a page of sell listings for a market item, sorted by price
+type ItemListings struct {
+	Start      int
+	PageSize   int
+	TotalCount int
+	Listings   []ItemListing
+}
+
+// SearchMarket searches the Steam market
+// opts: Search query, filters, sorting and paging
+func (b *Bot) SearchMarket(opts MarketSearchOptions) (*MarketSearchResponse, error) {
+	rateLimiter.Wait()
+
+	count := opts.Count
+	if count <= 0 {
+		count = 100
+	}
+
+	params := url.Values{}
+	params.Set("query", opts.Query)
+	params.Set("start", strconv.Itoa(opts.Start))
+	params.Set("count", strconv.Itoa(count))
+	params.Set("norender", "1")
+	if opts.SearchDescriptions {
+		params.Set("search_descriptions", "1")
+	} else {
+		params.Set("search_descriptions", "0")
+	}
+	if opts.SortColumn != "" {
+		params.Set("sort_column", opts.SortColumn)
+	}
+	if opts.SortDir != "" {
+		params.Set("sort_dir", opts.SortDir)
+	}
+	if opts.AppID != 0 {
+		params.Set("appid", strconv.Itoa(opts.AppID))
+		for category, tags := range opts.Tags {
+			key := fmt.Sprintf("category_%d_%s[]", opts.AppID, category)
+			for _, tag := range tags {
+				params.Add(key, tag)
+			}
+		}
+	}
+
+	var result MarketSearchResponse
+	err := getJSON(b.Session, "https://steamcommunity.com/market/search/render/?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return nil, fmt.Errorf("market search failed")
+	}
+
+	for i := range result.Results {
+		if _, currency, err := ParsePrice(result.Results[i].SellPriceText); err == nil {
+			result.Results[i].Currency = currency
+		}
+	}
+
+	return &result, nil
+}
+
+// GetItemListings fetches a page of individual sell listings for a market item
+// appID: Application ID (e.g., 730 for CS:GO)
+// marketHashName: Market hash name of the item
+// start: Offset of the first listing to return
+// count: Number of listings to return (at most 100)
+// currency: Currency the converted prices are returned in
+func (b *Bot) GetItemListings(appID int, marketHashName string, start, count int, currency Currency) (*ItemListings, error) {
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("start", strconv.Itoa(start))
+	params.Set("count", strconv.Itoa(count))
+	params.Set("currency", strconv.Itoa(currency.Code()))
+	params.Set("language", "english")
+	params.Set("format", "json")
+
+	listingsURL := fmt.Sprintf("https://steamcommunity.com/market/listings/%d/%s/render/?%s", appID, url.PathEscape(marketHashName), params.Encode())
+
+	var result struct {
+		Success     bool            `json:"success"`
+		Start       int             `json:"start"`
+		PageSize    int             `json:"pagesize"`
+		TotalCount  int             `json:"total_count"`
+		ListingInfo json.RawMessage `json:"listinginfo"`
+	}
+	err := getJSON(b.Session, listingsURL, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if !result.Success {
+		return nil, fmt.Errorf("fetching listings for %s failed", marketHashName)
+	}
+
+	listingInfo := make(map[string]ItemListing)
+	if err := decodeObject(result.ListingInfo, &listingInfo); err != nil {
+		return nil, fmt.Errorf("failed to decode listings: %w", err)
+	}
+
+	listings := make([]ItemListing, 0, len(listingInfo))
+	for _, listing := range listingInfo {
+		listings = append(listings, listing)
+	}
+	sort.Slice(listings, func(i, j int) bool {
+		return listings[i].BuyerPays() < listings[j].BuyerPays()
+	})
+
+	return &ItemListings{
+		Start:      result.Start,
+		PageSize:   result.PageSize,
+		TotalCount: result.TotalCount,
+		Listings:   listings,
+	}, nil
+}
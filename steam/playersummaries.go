@@ -0,0 +1,42 @@
+package steam
+
+import "strings"
+
+// playerSummariesBatchSize is the maximum number of SteamIDs GetPlayerSummaries accepts per request
+const playerSummariesBatchSize = 100
+
+// GetPlayerSummariesBatch fetches the summaries of any number of players, requesting them in
+// chunks of 100 SteamIDs. Duplicate IDs are requested once, and IDs Steam does not return
+// (e.g. deleted accounts) are missing from the result.
+// apiKey: Steam Web API key
+// steamIDs: SteamID64s of the players
+func GetPlayerSummariesBatch(apiKey string, steamIDs []string) (map[string]PlayerSummary, error) {
+	seen := make(map[string]bool, len(steamIDs))
+	unique := make([]string, 0, len(steamIDs))
+	for _, steamID := range steamIDs {
+		if steamID == "" || seen[steamID] {
+			continue
+		}
+		seen[steamID] = true
+		unique = append(unique, steamID)
+	}
+
+	summaries := make(map[string]PlayerSummary, len(unique))
+	for start := 0; start < len(unique); start += playerSummariesBatchSize {
+		end := start + playerSummariesBatchSize
+		if end > len(unique) {
+			end = len(unique)
+		}
+
+		// GetPlayerSummaries waits on the shared rate limiter for every chunk
+		result, err := GetPlayerSummaries(apiKey, strings.Join(unique[start:end], ","))
+		if err != nil {
+			return nil, err
+		}
+		for _, player := range result.Response.Players {
+			summaries[player.SteamID] = player
+		}
+	}
+
+	return summaries, nil
+}
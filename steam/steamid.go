@@ -0,0 +1,28 @@
+package steam
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// steamID64Base is the SteamID64 of the individual account with account ID 0
+const steamID64Base = 76561197960265728
+
+// SteamIDToAccountID converts a SteamID64 to a 32-bit account ID
+// steamID: SteamID64 of the user
+func SteamIDToAccountID(steamID string) (uint32, error) {
+	id, err := strconv.ParseUint(steamID, 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid SteamID64 %q: %w", steamID, err)
+	}
+	if id < steamID64Base {
+		return 0, fmt.Errorf("invalid SteamID64 %q: not an individual account", steamID)
+	}
+	return uint32(id - steamID64Base), nil
+}
+
+// AccountIDToSteamID converts a 32-bit account ID to a SteamID64
+// accountID: Account ID of the user
+func AccountIDToSteamID(accountID uint32) string {
+	return strconv.FormatUint(uint64(accountID)+steamID64Base, 10)
+}
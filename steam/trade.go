@@ -16,16 +16,65 @@ type TradeOffer struct {
 	ItemsToSend    string `json:"items_to_send"`
 	ItemsToReceive string `json:"items_to_receive"`
 	Message        string `json:"message"`
+
+	// AccessToken is the partner's trade offer access token, required if the partner is not a friend
+	AccessToken string `json:"trade_offer_access_token,omitempty"`
+
+	// RefuseEscrow makes SendTradeOffer check the trade hold durations first and
+	// refuse to send the offer if either side would be held longer than MaxEscrowDays
+	RefuseEscrow  bool `json:"-"`
+	MaxEscrowDays int  `json:"-"`
+
+	// Validate makes SendTradeOffer check the offered items against the live
+	// inventories first and return a *TradeValidationError if any problems are found
+	Validate bool `json:"-"`
 }
 
 // SendTradeOffer sends a trade offer
 // offer: TradeOffer struct containing trade offer details
-func (b *Bot) SendTradeOffer(offer TradeOffer) error {
+func (b *Bot) SendTradeOffer(offer TradeOffer) (err error) {
+	ids := make(map[string]string)
+	defer func() {
+		b.audit(AuditActionSendTradeOffer, map[string]interface{}{
+			"partner":          offer.PartnerSteamID,
+			"items_to_send":    offer.ItemsToSend,
+			"items_to_receive": offer.ItemsToReceive,
+			"message":          offer.Message,
+		}, ids, err)
+	}()
+
+	if offer.Validate {
+		problems, err := b.ValidateTradeOffer(offer)
+		if err != nil {
+			return fmt.Errorf("failed to validate trade offer: %w", err)
+		}
+		if len(problems) > 0 {
+			return &TradeValidationError{Problems: problems}
+		}
+	}
+
+	if offer.RefuseEscrow {
+		durations, err := b.GetTradeHoldDurations(offer.PartnerSteamID, offer.AccessToken)
+		if err != nil {
+			return fmt.Errorf("failed to get trade hold durations: %w", err)
+		}
+		if durations.MaxDays() > offer.MaxEscrowDays {
+			return fmt.Errorf("%w: my escrow %d days, their escrow %d days, max %d days", ErrTradeHoldExceeded, durations.MyEscrowDays, durations.TheirEscrowDays, offer.MaxEscrowDays)
+		}
+	}
+
 	data := url.Values{}
 	data.Set("sessionid", "your_session_id")
 	data.Set("partner", offer.PartnerSteamID)
 	data.Set("tradeoffermessage", offer.Message)
 	data.Set("json_tradeoffer", fmt.Sprintf(`{"newversion":true,"version":2,"me":{"assets":%s,"currency":[],"ready":false},"them":{"assets":%s,"currency":[],"ready":false}}`, offer.ItemsToSend, offer.ItemsToReceive))
+	if offer.AccessToken != "" {
+		createParams, err := json.Marshal(map[string]string{"trade_offer_access_token": offer.AccessToken})
+		if err != nil {
+			return fmt.Errorf("failed to encode trade offer create params: %w", err)
+		}
+		data.Set("trade_offer_create_params", string(createParams))
+	}
 
 	tradeOfferURL := fmt.Sprintf("https://steamcommunity.com/tradeoffer/new/send")
 
@@ -58,6 +107,11 @@ func (b *Bot) SendTradeOffer(offer TradeOffer) error {
 	if result["tradeofferid"] == nil {
 		return fmt.Errorf("trade offer failed: %v", result)
 	}
+	ids["tradeofferid"] = fmt.Sprintf("%v", result["tradeofferid"])
+
+	// Offered items are now held in the trade
+	InvalidateInventory(b.SteamID)
+	InvalidateInventory(offer.PartnerSteamID)
 
 	log.Printf("Trade offer sent to SteamID: %s\n", offer.PartnerSteamID)
 	return nil
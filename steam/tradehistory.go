@@ -0,0 +1,147 @@
+package steam
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// TradeStatus represents the status of a completed or in-progress trade (ETradeStatus)
+type TradeStatus int
+
+const (
+	TradeStatusInit                     TradeStatus = 0
+	TradeStatusPreCommitted             TradeStatus = 1
+	TradeStatusCommitted                TradeStatus = 2
+	TradeStatusComplete                 TradeStatus = 3
+	TradeStatusFailed                   TradeStatus = 4
+	TradeStatusPartialSupportRollback   TradeStatus = 5
+	TradeStatusFullSupportRollback      TradeStatus = 6
+	TradeStatusSupportRollbackSelective TradeStatus = 7
+	TradeStatusRollbackFailed           TradeStatus = 8
+	TradeStatusRollbackAbandoned        TradeStatus = 9
+	TradeStatusInEscrow                 TradeStatus = 10
+	TradeStatusEscrowRollback           TradeStatus = 11
+)
+
+// TradeAsset represents an asset exchanged in a trade, including its new IDs after the trade
+type TradeAsset struct {
+	AppID        int    `json:"appid"`
+	ContextID    string `json:"contextid"`
+	AssetID      string `json:"assetid"`
+	Amount       string `json:"amount"`
+	ClassID      string `json:"classid"`
+	InstanceID   string `json:"instanceid"`
+	NewAssetID   string `json:"new_assetid"`
+	NewContextID string `json:"new_contextid"`
+}
+
+// Trade represents a trade from the trade history
+type Trade struct {
+	TradeID        string       `json:"tradeid"`
+	SteamIDOther   string       `json:"steamid_other"`
+	TimeInit       int64        `json:"time_init"`
+	TimeEscrowEnd  int64        `json:"time_escrow_end"`
+	Status         TradeStatus  `json:"status"`
+	AssetsGiven    []TradeAsset `json:"assets_given"`
+	AssetsReceived []TradeAsset `json:"assets_received"`
+}
+
+// TradeHistoryOptions holds the parameters for a GetTradeHistory call
+type TradeHistoryOptions struct {
+	MaxTrades         int
+	StartAfterTime    int64
+	StartAfterTradeID string
+	NavigatingBack    bool
+	IncludeFailed     bool
+	IncludeTotal      bool
+}
+
+// TradeHistoryResponse represents the response from the GetTradeHistory API call
+type TradeHistoryResponse struct {
+	Response struct {
+		TotalTrades int     `json:"total_trades"`
+		More        bool    `json:"more"`
+		Trades      []Trade `json:"trades"`
+	} `json:"response"`
+}
+
+// NextPage returns the options for fetching the page after this one, or false if there are no more trades
+// opts: Options used to fetch this page
+func (r *TradeHistoryResponse) NextPage(opts TradeHistoryOptions) (TradeHistoryOptions, bool) {
+	trades := r.Response.Trades
+	if !r.Response.More || len(trades) == 0 {
+		return opts, false
+	}
+
+	last := trades[len(trades)-1]
+	opts.StartAfterTime = last.TimeInit
+	opts.StartAfterTradeID = last.TradeID
+	return opts, true
+}
+
+// tradeStatusResponse represents the response from the GetTradeStatus API call
+type tradeStatusResponse struct {
+	Response struct {
+		Trades []Trade `json:"trades"`
+	} `json:"response"`
+}
+
+// GetTradeHistory fetches a page of the trade history from the Steam API
+// apiKey: Steam Web API key
+// opts: Paging and filtering options
+func GetTradeHistory(apiKey string, opts TradeHistoryOptions) (*TradeHistoryResponse, error) {
+	rateLimiter.Wait()
+
+	maxTrades := opts.MaxTrades
+	if maxTrades <= 0 {
+		maxTrades = 100
+	}
+
+	params := url.Values{}
+	params.Set("key", apiKey)
+	params.Set("max_trades", strconv.Itoa(maxTrades))
+	params.Set("get_descriptions", "false")
+	params.Set("navigating_back", strconv.FormatBool(opts.NavigatingBack))
+	params.Set("include_failed", strconv.FormatBool(opts.IncludeFailed))
+	params.Set("include_total", strconv.FormatBool(opts.IncludeTotal))
+	if opts.StartAfterTime != 0 {
+		params.Set("start_after_time", strconv.FormatInt(opts.StartAfterTime, 10))
+	}
+	if opts.StartAfterTradeID != "" {
+		params.Set("start_after_tradeid", opts.StartAfterTradeID)
+	}
+
+	var result TradeHistoryResponse
+	err := getJSON(http.DefaultClient, "https://api.steampowered.com/IEconService/GetTradeHistory/v1/?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	return &result, nil
+}
+
+// GetTradeStatus fetches the status and exchanged assets of a single trade from the Steam API
+// apiKey: Steam Web API key
+// tradeID: ID of the trade (as returned in an accepted trade offer's tradeid)
+func GetTradeStatus(apiKey, tradeID string) (*Trade, error) {
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("key", apiKey)
+	params.Set("tradeid", tradeID)
+	params.Set("get_descriptions", "false")
+
+	var result tradeStatusResponse
+	err := getJSON(http.DefaultClient, "https://api.steampowered.com/IEconService/GetTradeStatus/v1/?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result.Response.Trades) == 0 {
+		return nil, fmt.Errorf("trade %s not found", tradeID)
+	}
+
+	return &result.Response.Trades[0], nil
+}
@@ -0,0 +1,196 @@
+package steam
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+)
+
+// TradeOfferState represents the state of a trade offer (ETradeOfferState)
+type TradeOfferState int
+
+const (
+	TradeOfferStateInvalid                  TradeOfferState = 1
+	TradeOfferStateActive                   TradeOfferState = 2
+	TradeOfferStateAccepted                 TradeOfferState = 3
+	TradeOfferStateCountered                TradeOfferState = 4
+	TradeOfferStateExpired                  TradeOfferState = 5
+	TradeOfferStateCanceled                 TradeOfferState = 6
+	TradeOfferStateDeclined                 TradeOfferState = 7
+	TradeOfferStateInvalidItems             TradeOfferState = 8
+	TradeOfferStateCreatedNeedsConfirmation TradeOfferState = 9
+	TradeOfferStateCanceledBySecondFactor   TradeOfferState = 10
+	TradeOfferStateInEscrow                 TradeOfferState = 11
+)
+
+// TradeOfferItem represents an item in a received or sent trade offer
+type TradeOfferItem struct {
+	AppID          int    `json:"appid"`
+	ContextID      string `json:"contextid"`
+	AssetID        string `json:"assetid"`
+	ClassID        string `json:"classid"`
+	InstanceID     string `json:"instanceid"`
+	Amount         string `json:"amount"`
+	Missing        bool   `json:"missing"`
+	MarketHashName string `json:"-"`
+}
+
+// ReceivedTradeOffer represents a trade offer as returned by the GetTradeOffers API call
+type ReceivedTradeOffer struct {
+	TradeOfferID       string           `json:"tradeofferid"`
+	AccountIDOther     uint32           `json:"accountid_other"`
+	Message            string           `json:"message"`
+	ExpirationTime     int64            `json:"expiration_time"`
+	State              TradeOfferState  `json:"trade_offer_state"`
+	ItemsToGive        []TradeOfferItem `json:"items_to_give"`
+	ItemsToReceive     []TradeOfferItem `json:"items_to_receive"`
+	IsOurOffer         bool             `json:"is_our_offer"`
+	TimeCreated        int64            `json:"time_created"`
+	TimeUpdated        int64            `json:"time_updated"`
+	EscrowEndDate      int64            `json:"escrow_end_date"`
+	ConfirmationMethod int              `json:"confirmation_method"`
+}
+
+// PartnerSteamID returns the SteamID64 of the other party of the trade offer
+func (o *ReceivedTradeOffer) PartnerSteamID() string {
+	return AccountIDToSteamID(o.AccountIDOther)
+}
+
+// tradeOffersResponse represents the response from the GetTradeOffers API call
+type tradeOffersResponse struct {
+	Response struct {
+		TradeOffersReceived []ReceivedTradeOffer `json:"trade_offers_received"`
+		Descriptions        []struct {
+			AppID          int    `json:"appid"`
+			ClassID        string `json:"classid"`
+			InstanceID     string `json:"instanceid"`
+			MarketHashName string `json:"market_hash_name"`
+		} `json:"descriptions"`
+	} `json:"response"`
+}
+
+// AcceptTradeOfferResult represents the response from accepting a trade offer
+type AcceptTradeOfferResult struct {
+	TradeID                 string `json:"tradeid"`
+	NeedsMobileConfirmation bool   `json:"needs_mobile_confirmation"`
+	NeedsEmailConfirmation  bool   `json:"needs_email_confirmation"`
+	StrError                string `json:"strError"`
+}
+
+// GetReceivedTradeOffers fetches the active trade offers received by the API key's account
+// apiKey: Steam Web API key
+func GetReceivedTradeOffers(apiKey string) ([]ReceivedTradeOffer, error) {
+	rateLimiter.Wait()
+
+	params := url.Values{}
+	params.Set("key", apiKey)
+	params.Set("get_received_offers", "1")
+	params.Set("active_only", "1")
+	params.Set("get_descriptions", "1")
+	params.Set("language", "english")
+
+	var result tradeOffersResponse
+	err := getJSON(http.DefaultClient, "https://api.steampowered.com/IEconService/GetTradeOffers/v1/?"+params.Encode(), &result)
+	if err != nil {
+		return nil, err
+	}
+
+	// Attach market hash names from the descriptions to each item
+	names := make(map[string]string)
+	for _, d := range result.Response.Descriptions {
+		names[fmt.Sprintf("%d_%s_%s", d.AppID, d.ClassID, d.InstanceID)] = d.MarketHashName
+	}
+	offers := result.Response.TradeOffersReceived
+	for i := range offers {
+		for _, items := range [][]TradeOfferItem{offers[i].ItemsToGive, offers[i].ItemsToReceive} {
+			for j := range items {
+				items[j].MarketHashName = names[fmt.Sprintf("%d_%s_%s", items[j].AppID, items[j].ClassID, items[j].InstanceID)]
+			}
+		}
+	}
+
+	return offers, nil
+}
+
+// AcceptTradeOffer accepts a received trade offer
+// tradeOfferID: ID of the trade offer to accept
+// partnerSteamID: SteamID64 of the user who sent the offer
+func (b *Bot) AcceptTradeOffer(tradeOfferID, partnerSteamID string) (accepted *AcceptTradeOfferResult, err error) {
+	defer func() {
+		ids := map[string]string{"tradeofferid": tradeOfferID}
+		if accepted != nil && accepted.TradeID != "" {
+			ids["tradeid"] = accepted.TradeID
+		}
+		b.audit(AuditActionAcceptTradeOffer, map[string]interface{}{"partner": partnerSteamID}, ids, err)
+	}()
+
+	data := url.Values{}
+	data.Set("sessionid", "your_session_id")
+	data.Set("serverid", "1")
+	data.Set("tradeofferid", tradeOfferID)
+	data.Set("partner", partnerSteamID)
+	data.Set("captcha", "")
+
+	acceptURL := fmt.Sprintf("https://steamcommunity.com/tradeoffer/%s/accept", tradeOfferID)
+
+	req, err := http.NewRequest("POST", acceptURL, bytes.NewBufferString(data.Encode()))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create accept trade offer request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Referer", fmt.Sprintf("https://steamcommunity.com/tradeoffer/%s/", tradeOfferID))
+
+	resp, err := b.Session.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send accept trade offer request: %w", err)
+	}
+	defer func(Body io.ReadCloser) {
+		err := Body.Close()
+		if err != nil {
+
+		}
+	}(resp.Body)
+
+	// Steam reports accept errors as JSON with a non-OK status, so decode before checking it
+	var result AcceptTradeOfferResult
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("steam API returned non-OK status: %s", resp.Status)
+		}
+		return nil, fmt.Errorf("failed to decode accept trade offer response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK || result.StrError != "" {
+		return nil, fmt.Errorf("accepting trade offer failed: %s %s", resp.Status, result.StrError)
+	}
+
+	InvalidateInventory(b.SteamID)
+	InvalidateInventory(partnerSteamID)
+
+	log.Printf("Trade offer %s accepted\n", tradeOfferID)
+	return &result, nil
+}
+
+// DeclineTradeOffer declines a received trade offer
+// tradeOfferID: ID of the trade offer to decline
+func (b *Bot) DeclineTradeOffer(tradeOfferID string) (err error) {
+	defer func() {
+		b.audit(AuditActionDeclineTradeOffer, nil, map[string]string{"tradeofferid": tradeOfferID}, err)
+	}()
+
+	data := url.Values{}
+	data.Set("key", b.APIKey)
+	data.Set("tradeofferid", tradeOfferID)
+
+	err = postForm(http.DefaultClient, "https://api.steampowered.com/IEconService/DeclineTradeOffer/v1/", data, nil)
+	if err != nil {
+		return fmt.Errorf("failed to decline trade offer: %w", err)
+	}
+
+	log.Printf("Trade offer %s declined\n", tradeOfferID)
+	return nil
+}
@@ -0,0 +1,181 @@
+package steam
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TradeSide identifies which side of a trade offer an item belongs to
+type TradeSide string
+
+const (
+	TradeSideSend    TradeSide = "send"
+	TradeSideReceive TradeSide = "receive"
+)
+
+// TradeProblemReason describes why an item in a trade offer failed validation
+type TradeProblemReason string
+
+const (
+	TradeProblemNotOwned           TradeProblemReason = "not_owned"
+	TradeProblemUntradable         TradeProblemReason = "untradable"
+	TradeProblemInsufficientAmount TradeProblemReason = "insufficient_amount"
+	TradeProblemUnknownDescription TradeProblemReason = "unknown_description"
+)
+
+// TradeOfferAsset represents an item in the assets list of a trade offer
+type TradeOfferAsset struct {
+	AppID     int         `json:"appid"`
+	ContextID string      `json:"contextid"`
+	AssetID   string      `json:"assetid"`
+	Amount    json.Number `json:"amount"`
+}
+
+// TradeProblem represents a single validation problem found in a trade offer
+type TradeProblem struct {
+	Side      TradeSide          `json:"side"`
+	AppID     int                `json:"appid"`
+	ContextID string             `json:"contextid"`
+	AssetID   string             `json:"assetid"`
+	Reason    TradeProblemReason `json:"reason"`
+}
+
+// TradeValidationError is returned when a trade offer fails pre-flight validation
+type TradeValidationError struct {
+	Problems []TradeProblem
+}
+
+func (e *TradeValidationError) Error() string {
+	parts := make([]string, 0, len(e.Problems))
+	for _, p := range e.Problems {
+		parts = append(parts, fmt.Sprintf("%s %d/%s/%s: %s", p.Side, p.AppID, p.ContextID, p.AssetID, p.Reason))
+	}
+	return fmt.Sprintf("trade offer validation failed: %s", strings.Join(parts, "; "))
+}
+
+// ParseTradeOfferAssets parses the JSON assets list used in TradeOffer.ItemsToSend and ItemsToReceive
+// raw: JSON array of assets
+func ParseTradeOfferAssets(raw string) ([]TradeOfferAsset, error) {
+	if strings.TrimSpace(raw) == "" {
+		return nil, nil
+	}
+
+	var assets []TradeOfferAsset
+	if err := json.Unmarshal([]byte(raw), &assets); err != nil {
+		return nil, fmt.Errorf("failed to parse trade offer assets: %w", err)
+	}
+	return assets, nil
+}
+
+// ValidateTradeOffer cross-checks a trade offer against the live inventories of the bot and the partner.
+// ItemsToSend are checked against the bot's inventory (b.SteamID must be set if there are any) and
+// ItemsToReceive against the partner's public inventory. An empty list means the offer is valid.
+// offer: TradeOffer struct containing trade offer details
+func (b *Bot) ValidateTradeOffer(offer TradeOffer) ([]TradeProblem, error) {
+	itemsToSend, err := ParseTradeOfferAssets(offer.ItemsToSend)
+	if err != nil {
+		return nil, err
+	}
+	if len(itemsToSend) > 0 && b.SteamID == "" {
+		return nil, fmt.Errorf("bot SteamID is not set")
+	}
+	itemsToReceive, err := ParseTradeOfferAssets(offer.ItemsToReceive)
+	if err != nil {
+		return nil, err
+	}
+
+	problems, err := b.validateTradeAssets(TradeSideSend, b.SteamID, itemsToSend)
+	if err != nil {
+		return nil, err
+	}
+	receiveProblems, err := b.validateTradeAssets(TradeSideReceive, offer.PartnerSteamID, itemsToReceive)
+	if err != nil {
+		return nil, err
+	}
+
+	return append(problems, receiveProblems...), nil
+}
+
+// validateTradeAssets checks a list of trade offer assets against the inventory of their owner
+// side: Side of the trade the assets belong to
+// steamID: SteamID64 of the owner of the assets
+// assets: Assets to check
+func (b *Bot) validateTradeAssets(side TradeSide, steamID string, assets []TradeOfferAsset) ([]TradeProblem, error) {
+	type inventoryKey struct {
+		appID     int
+		contextID string
+	}
+
+	// Load each referenced inventory only once
+	inventories := make(map[inventoryKey]*PlayerInventoryResponse)
+	for _, asset := range assets {
+		key := inventoryKey{asset.AppID, asset.ContextID}
+		if _, ok := inventories[key]; ok {
+			continue
+		}
+
+		contextID, err := strconv.Atoi(asset.ContextID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid context ID %q: %w", asset.ContextID, err)
+		}
+
+		// Validate against the live inventory, never a cached one
+		inventory, err := CollectInventory(NewInventoryIterator(steamID, asset.AppID, contextID))
+		if err != nil {
+			return nil, fmt.Errorf("failed to load inventory %d/%s of %s: %w", asset.AppID, asset.ContextID, steamID, err)
+		}
+		inventories[key] = inventory
+	}
+
+	var problems []TradeProblem
+	for _, asset := range assets {
+		inventory := inventories[inventoryKey{asset.AppID, asset.ContextID}]
+		problem := TradeProblem{
+			Side:      side,
+			AppID:     asset.AppID,
+			ContextID: asset.ContextID,
+			AssetID:   asset.AssetID,
+		}
+
+		found := false
+		for _, owned := range inventory.Assets {
+			if owned.AssetID != asset.AssetID {
+				continue
+			}
+			found = true
+
+			wanted, _ := strconv.Atoi(asset.Amount.String())
+			have, _ := strconv.Atoi(owned.Amount)
+			if wanted > have {
+				problem.Reason = TradeProblemInsufficientAmount
+				problems = append(problems, problem)
+				break
+			}
+
+			// Without its description the asset's tradability is unknown, so it cannot pass
+			problem.Reason = TradeProblemUnknownDescription
+			for _, description := range inventory.Descriptions {
+				if description.ClassID == owned.ClassID && description.InstanceID == owned.InstanceID {
+					problem.Reason = ""
+					if description.Tradable == 0 {
+						problem.Reason = TradeProblemUntradable
+					}
+					break
+				}
+			}
+			if problem.Reason != "" {
+				problems = append(problems, problem)
+			}
+			break
+		}
+
+		if !found {
+			problem.Reason = TradeProblemNotOwned
+			problems = append(problems, problem)
+		}
+	}
+
+	return problems, nil
+}
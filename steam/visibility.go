@@ -0,0 +1,96 @@
+package steam
+
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrPrivate is matched by errors.Is for inventories and profiles that are not visible to the caller
+var ErrPrivate = errors.New("profile or inventory is not public")
+
+// Visibility represents a profile's communityvisibilitystate.
+// The Web API reports every non-public profile as VisibilityPrivate unless the caller is allowed to see it.
+type Visibility int
+
+const (
+	VisibilityPrivate     Visibility = 1
+	VisibilityFriendsOnly Visibility = 2
+	VisibilityPublic      Visibility = 3
+)
+
+// String returns the visibility's name
+func (v Visibility) String() string {
+	switch v {
+	case VisibilityPrivate:
+		return "private"
+	case VisibilityFriendsOnly:
+		return "friends only"
+	case VisibilityPublic:
+		return "public"
+	default:
+		return fmt.Sprintf("Visibility(%d)", int(v))
+	}
+}
+
+// PrivateInventoryError is returned when an inventory cannot be loaded because it is private or friends-only.
+// Steam answers both the same way, so Visibility is always VisibilityPrivate.
+type PrivateInventoryError struct {
+	SteamID    string
+	AppID      int
+	ContextID  int
+	Visibility Visibility
+}
+
+func (e *PrivateInventoryError) Error() string {
+	return fmt.Sprintf("inventory %d/%d of %s is %s", e.AppID, e.ContextID, e.SteamID, e.Visibility)
+}
+
+// Is makes errors.Is(err, ErrPrivate) report true
+func (e *PrivateInventoryError) Is(target error) bool {
+	return target == ErrPrivate
+}
+
+// PrivateProfileError is returned when a profile is not public
+type PrivateProfileError struct {
+	SteamID    string
+	Visibility Visibility
+}
+
+func (e *PrivateProfileError) Error() string {
+	return fmt.Sprintf("profile of %s is %s", e.SteamID, e.Visibility)
+}
+
+// Is makes errors.Is(err, ErrPrivate) report true
+func (e *PrivateProfileError) Is(target error) bool {
+	return target == ErrPrivate
+}
+
+// GetProfileVisibility fetches a player's profile visibility from the GetPlayerSummaries API call
+// apiKey: Steam Web API key
+// steamID: SteamID64 of the player
+func GetProfileVisibility(apiKey, steamID string) (Visibility, error) {
+	summaries, err := GetPlayerSummaries(apiKey, steamID)
+	if err != nil {
+		return 0, err
+	}
+	for _, player := range summaries.Response.Players {
+		if player.SteamID == steamID {
+			return Visibility(player.CommunityVisibilityState), nil
+		}
+	}
+	return 0, fmt.Errorf("player %s not found", steamID)
+}
+
+// CheckProfileVisibility returns a *PrivateProfileError if a player's profile is not public
+// apiKey: Steam Web API key
+// steamID: SteamID64 of the player
+func CheckProfileVisibility(apiKey, steamID string) error {
+	visibility, err := GetProfileVisibility(apiKey, steamID)
+	if err != nil {
+		return err
+	}
+	if visibility != VisibilityPublic {
+		return &PrivateProfileError{SteamID: steamID, Visibility: visibility}
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package steam
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// walletInfoRegexp extracts the g_rgWalletInfo object from the market page
+var walletInfoRegexp = regexp.MustCompile(`var g_rgWalletInfo\s*=\s*(\{.*?\});`)
+
+// WalletInfo represents the bot's Steam wallet.
+// Balances are in hundredths of Currency.
+type WalletInfo struct {
+	Currency        Currency  `json:"currency"`
+	Country         string    `json:"country"`
+	Balance         int       `json:"balance"`
+	PendingBalance  int       `json:"pending_balance"`
+	MaxBalance      int       `json:"max_balance"`
+	TradeMaxBalance int       `json:"trade_max_balance"`
+	FeeParams       FeeParams `json:"fee_params"`
+}
+
+// FormattedBalance returns the balance formatted the way Steam displays it
+func (w *WalletInfo) FormattedBalance() string {
+	return w.Currency.FormatPrice(w.Balance)
+}
+
+// walletInfoResponse represents the g_rgWalletInfo object embedded in the market page
+type walletInfoResponse struct {
+	Success                          int         `json:"success"`
+	WalletCurrency                   int         `json:"wallet_currency"`
+	WalletCountry                    string      `json:"wallet_country"`
+	WalletBalance                    json.Number `json:"wallet_balance"`
+	WalletDelayedBalance             json.Number `json:"wallet_delayed_balance"`
+	WalletMaxBalance                 json.Number `json:"wallet_max_balance"`
+	WalletTradeMaxBalance            json.Number `json:"wallet_trade_max_balance"`
+	WalletFeeMinimum                 json.Number `json:"wallet_fee_minimum"`
+	WalletFeePercent                 json.Number `json:"wallet_fee_percent"`
+	WalletFeeBase                    json.Number `json:"wallet_fee_base"`
+	WalletPublisherFeePercentDefault json.Number `json:"wallet_publisher_fee_percent_default"`
+}
+
+// GetWalletInfo fetches the bot's wallet currency, balance, pending balance and maximum balance
+// from the market page; requires a logged-in session
+func (b *Bot) GetWalletInfo() (*WalletInfo, error) {
+	rateLimiter.Wait()
+
+	body, err := getBody(b.Session, "https://steamcommunity.com/market/")
+	if err != nil {
+		return nil, err
+	}
+
+	match := walletInfoRegexp.FindSubmatch(body)
+	if match == nil {
+		return nil, fmt.Errorf("wallet info not found on market page")
+	}
+
+	var result walletInfoResponse
+	if err := json.Unmarshal(match[1], &result); err != nil {
+		return nil, fmt.Errorf("failed to decode wallet info: %w", err)
+	}
+
+	if result.Success != 1 {
+		return nil, fmt.Errorf("wallet info not available; is the bot logged in?")
+	}
+
+	currency := Currency(result.WalletCurrency)
+	if !currency.Valid() {
+		return nil, fmt.Errorf("unknown wallet currency: %d", result.WalletCurrency)
+	}
+
+	atoi := func(n json.Number) int {
+		v, _ := strconv.Atoi(n.String())
+		return v
+	}
+	atof := func(n json.Number, fallback float64) float64 {
+		v, err := strconv.ParseFloat(n.String(), 64)
+		if err != nil {
+			return fallback
+		}
+		return v
+	}
+
+	return &WalletInfo{
+		Currency:        currency,
+		Country:         result.WalletCountry,
+		Balance:         atoi(result.WalletBalance),
+		PendingBalance:  atoi(result.WalletDelayedBalance),
+		MaxBalance:      atoi(result.WalletMaxBalance),
+		TradeMaxBalance: atoi(result.WalletTradeMaxBalance),
+		FeeParams: FeeParams{
+			FeePercent:                 atof(result.WalletFeePercent, DefaultFeeParams.FeePercent),
+			FeeMinimum:                 atoi(result.WalletFeeMinimum),
+			FeeBase:                    atoi(result.WalletFeeBase),
+			PublisherFeePercentDefault: atof(result.WalletPublisherFeePercentDefault, DefaultFeeParams.PublisherFeePercentDefault),
+		},
+	}, nil
+}